@@ -8,6 +8,7 @@ require (
 	github.com/spf13/cobra v1.8.0
 	google.golang.org/grpc v1.72.0
 	google.golang.org/protobuf v1.36.5
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
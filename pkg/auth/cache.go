@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+)
+
+// validationCacheTTL is how long a successful credential validation is trusted
+// before the mothership is asked again
+const validationCacheTTL = 5 * time.Minute
+
+// validationCache records the last successful validation of a set of credentials
+type validationCache struct {
+	Fingerprint string    `json:"fingerprint"`
+	ValidUntil  time.Time `json:"valid_until"`
+}
+
+func validationCachePath() string {
+	return filepath.Join(config.GetCacheDir(), "auth.json")
+}
+
+// credentialFingerprint identifies the credentials without storing them in the cache
+func credentialFingerprint(cfg *config.Config) string {
+	sum := sha256.Sum256([]byte(cfg.User.Email + "\x00" + cfg.User.AuthToken + "\x00" + cfg.Cluster.ClusterToken))
+	return hex.EncodeToString(sum[:])
+}
+
+func hasCachedValidation(cfg *config.Config) bool {
+	data, err := os.ReadFile(validationCachePath())
+	if err != nil {
+		return false
+	}
+
+	var cache validationCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return false
+	}
+
+	return cache.Fingerprint == credentialFingerprint(cfg) && time.Now().Before(cache.ValidUntil)
+}
+
+func saveCachedValidation(cfg *config.Config, validUntil time.Time) {
+	data, err := json.Marshal(validationCache{
+		Fingerprint: credentialFingerprint(cfg),
+		ValidUntil:  validUntil,
+	})
+	if err != nil {
+		return
+	}
+
+	// The cache is an optimisation only, so failures to write it are ignored
+	if err := os.MkdirAll(config.GetCacheDir(), 0700); err != nil {
+		return
+	}
+	_ = os.WriteFile(validationCachePath(), data, 0600)
+}
+
+func clearCachedValidation() {
+	_ = os.Remove(validationCachePath())
+}
@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// SkipAuthAnnotation marks a command (and its subcommands) as not requiring authentication
+const SkipAuthAnnotation = "nsai/skip-auth"
+
+// Session holds the authenticated client and config for the running command
+type Session struct {
+	Client *client.Client
+	Config *config.Config
+}
+
+type sessionKey struct{}
+
+// SkipAuth marks the command as not requiring authentication and returns it
+func SkipAuth(cmd *cobra.Command) *cobra.Command {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[SkipAuthAnnotation] = "true"
+	return cmd
+}
+
+// requiresAuth reports whether the command or any of its parents opted out of authentication
+func requiresAuth(cmd *cobra.Command) bool {
+	for c := cmd; c != nil; c = c.Parent() {
+		if c.Annotations[SkipAuthAnnotation] == "true" {
+			return false
+		}
+	}
+
+	// Cobra's built-in help and completion commands never need credentials
+	switch cmd.Name() {
+	case "help", "completion", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return false
+	}
+
+	return true
+}
+
+// PersistentPreRunE resolves the current context, validates the stored credentials
+// and attaches an authenticated Session to the command context. The client is closed by
+// CloseSession once the command has run.
+func PersistentPreRunE(cmd *cobra.Command, args []string) error {
+	if !requiresAuth(cmd) {
+		return nil
+	}
+
+	if !config.ConfigExists() {
+		printAuthHint("No configuration found.")
+		return fmt.Errorf(utils.ErrAuthRequired)
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("%s: %v", utils.ErrConfigLoadFailed, err)
+	}
+
+	if cfg.User.AuthToken == "" || cfg.User.Email == "" {
+		printAuthHint("No authentication token found.")
+		return fmt.Errorf(utils.ErrAuthRequired)
+	}
+
+	c, err := client.NewClient("", true, "")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.WithContext(cmd.Context())
+	defer cancel()
+
+	validator := NewValidatorWithClient(c, cfg)
+	if err := validator.ValidateAllCached(ctx); err != nil {
+		c.Close()
+		printAuthHint(fmt.Sprintf("Authentication failed: %v", err))
+		return fmt.Errorf(utils.ErrAuthRequired)
+	}
+
+	cmd.SetContext(NewContext(cmd.Context(), &Session{
+		Client: c,
+		Config: cfg,
+	}))
+
+	return nil
+}
+
+// CloseSession closes the client of the Session attached to ctx by PersistentPreRunE, if any
+func CloseSession(ctx context.Context) {
+	if ctx == nil {
+		return
+	}
+	if s, ok := ctx.Value(sessionKey{}).(*Session); ok && s != nil {
+		s.Client.Close()
+	}
+}
+
+// NewContext returns a copy of ctx carrying the given Session
+func NewContext(ctx context.Context, s *Session) context.Context {
+	return context.WithValue(ctx, sessionKey{}, s)
+}
+
+// SessionFromContext returns the authenticated Session attached by PersistentPreRunE
+func SessionFromContext(ctx context.Context) (*Session, error) {
+	s, ok := ctx.Value(sessionKey{}).(*Session)
+	if !ok || s == nil {
+		return nil, fmt.Errorf(utils.ErrAuthRequired)
+	}
+	return s, nil
+}
+
+func printAuthHint(reason string) {
+	fmt.Println(reason)
+	fmt.Println("\nPlease authenticate first:")
+	fmt.Println("1. Sign in: 'nsai auth signin'")
+	fmt.Println("2. Sign up: 'nsai auth signup'")
+}
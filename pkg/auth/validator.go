@@ -3,16 +3,22 @@ package auth
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
 	authproto "github.com/nstreama-ai/nstream-ai-cli/proto/auth"
 )
 
 // Validator handles authentication validation
 type Validator struct {
-	client *client.Client
-	config *config.Config
+	client     *client.Client
+	config     *config.Config
+	ownsClient bool
+
+	// tokenExpiry is the auth token expiry reported by the last ValidateToken call
+	tokenExpiry time.Time
 }
 
 // NewValidator creates a new Validator instance
@@ -27,10 +33,20 @@ func NewValidator() (*Validator, error) {
 		return nil, fmt.Errorf("failed to load config: %v", err)
 	}
 
+	return &Validator{
+		client:     c,
+		config:     cfg,
+		ownsClient: true,
+	}, nil
+}
+
+// NewValidatorWithClient creates a Validator that reuses an existing client and config.
+// The client is not closed by Close.
+func NewValidatorWithClient(c *client.Client, cfg *config.Config) *Validator {
 	return &Validator{
 		client: c,
 		config: cfg,
-	}, nil
+	}
 }
 
 // ValidateUser checks if the user is valid
@@ -62,6 +78,10 @@ func (v *Validator) ValidateToken(ctx context.Context) error {
 		return fmt.Errorf("authentication token is invalid: %s", tokenResp.Error)
 	}
 
+	if tokenResp.ExpiresAt != nil {
+		v.tokenExpiry = tokenResp.ExpiresAt.AsTime()
+	}
+
 	return nil
 }
 
@@ -102,9 +122,45 @@ func (v *Validator) ValidateAll(ctx context.Context) error {
 	return nil
 }
 
+// ValidateAllCached checks the user and auth token unless a recent successful validation
+// for the same credentials is cached locally. An invalid cluster token is removed from the
+// config with a warning, as 'nsai init' does, so that it cannot block the commands that
+// recover from it such as 'nsai use cluster' and 'nsai delete cluster'.
+func (v *Validator) ValidateAllCached(ctx context.Context) error {
+	if hasCachedValidation(v.config) {
+		return nil
+	}
+
+	if err := v.ValidateUser(ctx); err != nil {
+		clearCachedValidation()
+		return err
+	}
+
+	if err := v.ValidateToken(ctx); err != nil {
+		clearCachedValidation()
+		return err
+	}
+
+	if err := v.ValidateClusterToken(ctx); err != nil {
+		fmt.Printf("%sWarning:%s %v; removing it from the config.\n", utils.BoldColor, utils.ResetColor, err)
+		v.config.Cluster.ClusterToken = ""
+		if err := config.SaveConfig(v.config); err != nil {
+			return fmt.Errorf("failed to save config: %v", err)
+		}
+	}
+
+	validUntil := time.Now().Add(validationCacheTTL)
+	if !v.tokenExpiry.IsZero() && v.tokenExpiry.Before(validUntil) {
+		validUntil = v.tokenExpiry
+	}
+	saveCachedValidation(v.config, validUntil)
+
+	return nil
+}
+
 // Close closes the client connection
 func (v *Validator) Close() {
-	if v.client != nil {
+	if v.client != nil && v.ownsClient {
 		v.client.Close()
 	}
 }
@@ -0,0 +1,186 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+// FormatVersion is the archive format written by this CLI. Archives with a newer
+// version are rejected rather than restored partially.
+const FormatVersion = 1
+
+// FileExtension is the conventional suffix of backup archives
+const FileExtension = ".nsai-backup.tar.gz"
+
+const manifestFile = "manifest.json"
+
+// Manifest describes the contents of a backup archive
+type Manifest struct {
+	Version   int       `json:"version"`
+	Cluster   string    `json:"cluster"`
+	CreatedAt time.Time `json:"created_at"`
+	Resources []Entry   `json:"resources"`
+}
+
+// Entry describes one resource in a backup archive
+type Entry struct {
+	Kind      string   `json:"kind"`
+	Name      string   `json:"name"`
+	DependsOn []string `json:"depends_on,omitempty"`
+	File      string   `json:"file"`
+}
+
+// Archive is a decoded backup
+type Archive struct {
+	Manifest  Manifest
+	Resources []*clusterproto.ResourceSpec
+}
+
+// FileName returns the default archive name for a cluster backup taken at t
+func FileName(cluster string, t time.Time) string {
+	return fmt.Sprintf("%s-%s%s", cluster, t.UTC().Format("20060102-150405"), FileExtension)
+}
+
+// Write encodes resources as a gzip-compressed tar archive with a manifest and one
+// JSON file per resource
+func Write(w io.Writer, cluster string, createdAt time.Time, resources []*clusterproto.ResourceSpec) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	manifest := Manifest{
+		Version:   FormatVersion,
+		Cluster:   cluster,
+		CreatedAt: createdAt.UTC(),
+	}
+
+	for _, r := range resources {
+		entry := Entry{
+			Kind:      r.Kind,
+			Name:      r.Name,
+			DependsOn: r.DependsOn,
+			File:      path.Join("resources", r.Kind, r.Name+".json"),
+		}
+
+		spec, err := indentJSON([]byte(r.Spec))
+		if err != nil {
+			return fmt.Errorf("invalid spec for %s/%s: %v", r.Kind, r.Name, err)
+		}
+		if err := writeFile(tw, entry.File, spec, createdAt); err != nil {
+			return err
+		}
+		manifest.Resources = append(manifest.Resources, entry)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %v", err)
+	}
+	if err := writeFile(tw, manifestFile, append(data, '\n'), createdAt); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to write archive: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to write archive: %v", err)
+	}
+	return nil
+}
+
+// Read decodes a backup archive written by Write
+func Read(r io.Reader) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("not a backup archive: %v", err)
+	}
+	defer gz.Close()
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archive: %v", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archive: %v", err)
+		}
+		files[hdr.Name] = data
+	}
+
+	data, ok := files[manifestFile]
+	if !ok {
+		return nil, fmt.Errorf("not a backup archive: %s is missing", manifestFile)
+	}
+
+	archive := &Archive{}
+	if err := json.Unmarshal(data, &archive.Manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %v", err)
+	}
+	if archive.Manifest.Version < 1 || archive.Manifest.Version > FormatVersion {
+		return nil, fmt.Errorf("unsupported backup format version %d (this CLI supports up to %d)", archive.Manifest.Version, FormatVersion)
+	}
+
+	for _, entry := range archive.Manifest.Resources {
+		spec, ok := files[entry.File]
+		if !ok {
+			return nil, fmt.Errorf("invalid archive: %s for %s/%s is missing", entry.File, entry.Kind, entry.Name)
+		}
+
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, spec); err != nil {
+			return nil, fmt.Errorf("invalid spec for %s/%s: %v", entry.Kind, entry.Name, err)
+		}
+
+		archive.Resources = append(archive.Resources, &clusterproto.ResourceSpec{
+			Kind:      entry.Kind,
+			Name:      entry.Name,
+			DependsOn: entry.DependsOn,
+			Spec:      buf.String(),
+		})
+	}
+
+	return archive, nil
+}
+
+func writeFile(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("failed to write archive: %v", err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write archive: %v", err)
+	}
+	return nil
+}
+
+func indentJSON(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
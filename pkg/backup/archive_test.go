@@ -0,0 +1,142 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+	"time"
+
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+var createdAt = time.Date(2026, 3, 4, 5, 6, 7, 0, time.FixedZone("CET", 3600))
+
+func TestWriteRead(t *testing.T) {
+	resources := []*clusterproto.ResourceSpec{
+		{Kind: "connector", Name: "docs", Spec: `{"source":"s3://docs","schedule":"@daily"}`},
+		{Kind: "knowledgebase", Name: "support", DependsOn: []string{"connector/docs", "model/embed"}, Spec: `{"chunk_size":512,"tags":["a","b"]}`},
+		{Kind: "model", Name: "embed", Spec: `{"base":"bge-small","replicas":2,"env":null}`},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "prod", createdAt, resources); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	archive, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	m := archive.Manifest
+	if m.Version != FormatVersion || m.Cluster != "prod" || !m.CreatedAt.Equal(createdAt) || m.CreatedAt.Location() != time.UTC {
+		t.Errorf("manifest = %+v, want version %d of prod created at %v in UTC", m, FormatVersion, createdAt)
+	}
+	if len(m.Resources) != len(resources) || m.Resources[1].File != "resources/knowledgebase/support.json" {
+		t.Errorf("manifest resources = %+v", m.Resources)
+	}
+
+	// Specs are stored indented and come back compact, in the order they were written
+	if len(archive.Resources) != len(resources) {
+		t.Fatalf("Read() returned %d resources, want %d", len(archive.Resources), len(resources))
+	}
+	for i, want := range resources {
+		got := archive.Resources[i]
+		if got.Kind != want.Kind || got.Name != want.Name || got.Spec != want.Spec || strings.Join(got.DependsOn, " ") != strings.Join(want.DependsOn, " ") {
+			t.Errorf("resource %d = %+v, want %+v", i, got, want)
+		}
+	}
+}
+
+func TestWriteInvalidSpec(t *testing.T) {
+	resources := []*clusterproto.ResourceSpec{{Kind: "model", Name: "embed", Spec: `{"base":`}}
+	if err := Write(&bytes.Buffer{}, "prod", createdAt, resources); err == nil || !strings.Contains(err.Error(), "invalid spec for model/embed") {
+		t.Errorf("Write() error = %v, want an invalid spec", err)
+	}
+}
+
+// archiveOf builds a gzip-compressed tar archive holding the given files
+func archiveOf(t *testing.T, files map[string]string) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, data := range files {
+		if err := writeFile(tw, name, []byte(data), createdAt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &buf
+}
+
+func TestReadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name:    "no manifest",
+			files:   map[string]string{"resources/model/embed.json": `{}`},
+			wantErr: "manifest.json is missing",
+		},
+		{
+			name:    "invalid manifest",
+			files:   map[string]string{manifestFile: `{"version":`},
+			wantErr: "invalid manifest",
+		},
+		{
+			name:    "newer format",
+			files:   map[string]string{manifestFile: `{"version":2,"cluster":"prod"}`},
+			wantErr: "unsupported backup format version 2",
+		},
+		{
+			name:    "no version",
+			files:   map[string]string{manifestFile: `{"cluster":"prod"}`},
+			wantErr: "unsupported backup format version 0",
+		},
+		{
+			name: "missing resource file",
+			files: map[string]string{
+				manifestFile: `{"version":1,"resources":[{"kind":"model","name":"embed","file":"resources/model/embed.json"}]}`,
+			},
+			wantErr: "resources/model/embed.json for model/embed is missing",
+		},
+		{
+			name: "invalid resource file",
+			files: map[string]string{
+				manifestFile:                 `{"version":1,"resources":[{"kind":"model","name":"embed","file":"resources/model/embed.json"}]}`,
+				"resources/model/embed.json": `{"base"`,
+			},
+			wantErr: "invalid spec for model/embed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(archiveOf(t, tt.files))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Read() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := Read(strings.NewReader(`{"version":1}`)); err == nil || !strings.Contains(err.Error(), "not a backup archive") {
+		t.Errorf("Read() of a file that is not gzip-compressed error = %v", err)
+	}
+}
+
+func TestFileName(t *testing.T) {
+	if got, want := FileName("prod", createdAt), "prod-20260304-040607"+FileExtension; got != want {
+		t.Errorf("FileName() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,88 @@
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+// kindOrder breaks ties between independent resources so restores run in a stable,
+// natural order: sources and models before what is built on top of them
+var kindOrder = map[string]int{
+	"connector":     0,
+	"model":         1,
+	"knowledgebase": 2,
+	"finetuner":     3,
+	"graph":         4,
+}
+
+// Ref returns the "kind/name" reference of a resource as used in depends_on
+func Ref(r *clusterproto.ResourceSpec) string {
+	return r.Kind + "/" + r.Name
+}
+
+// Order sorts resources so that every resource comes after the resources it depends on.
+// Dependencies outside of resources are assumed to already exist on the target cluster.
+func Order(resources []*clusterproto.ResourceSpec) ([]*clusterproto.ResourceSpec, error) {
+	sorted := make([]*clusterproto.ResourceSpec, len(resources))
+	copy(sorted, resources)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ki, kj := rank(sorted[i].Kind), rank(sorted[j].Kind)
+		if ki != kj {
+			return ki < kj
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	byRef := make(map[string]*clusterproto.ResourceSpec, len(sorted))
+	for _, r := range sorted {
+		byRef[Ref(r)] = r
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int, len(sorted))
+	ordered := make([]*clusterproto.ResourceSpec, 0, len(sorted))
+
+	var visit func(r *clusterproto.ResourceSpec, path []string) error
+	visit = func(r *clusterproto.ResourceSpec, path []string) error {
+		ref := Ref(r)
+		switch state[ref] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("dependency cycle: %s", strings.Join(append(path, ref), " -> "))
+		}
+
+		state[ref] = visiting
+		for _, dep := range r.DependsOn {
+			if d, ok := byRef[dep]; ok {
+				if err := visit(d, append(path, ref)); err != nil {
+					return err
+				}
+			}
+		}
+		state[ref] = visited
+		ordered = append(ordered, r)
+		return nil
+	}
+
+	for _, r := range sorted {
+		if err := visit(r, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
+
+func rank(kind string) int {
+	if r, ok := kindOrder[kind]; ok {
+		return r
+	}
+	return len(kindOrder)
+}
@@ -0,0 +1,123 @@
+package backup
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+func spec(ref string, dependsOn ...string) *clusterproto.ResourceSpec {
+	kind, name, _ := strings.Cut(ref, "/")
+	return &clusterproto.ResourceSpec{Kind: kind, Name: name, DependsOn: dependsOn}
+}
+
+func refs(resources []*clusterproto.ResourceSpec) []string {
+	out := make([]string, 0, len(resources))
+	for _, r := range resources {
+		out = append(out, Ref(r))
+	}
+	return out
+}
+
+func TestOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources []*clusterproto.ResourceSpec
+		want      []string
+	}{
+		{
+			name:      "empty",
+			resources: nil,
+			want:      []string{},
+		},
+		{
+			// Without dependencies resources are ordered by kind, then name
+			name: "independent resources",
+			resources: []*clusterproto.ResourceSpec{
+				spec("graph/qa"),
+				spec("widget/x"),
+				spec("model/b"),
+				spec("model/a"),
+				spec("connector/docs"),
+			},
+			want: []string{"connector/docs", "model/a", "model/b", "graph/qa", "widget/x"},
+		},
+		{
+			name: "dependencies come first",
+			resources: []*clusterproto.ResourceSpec{
+				spec("graph/qa", "knowledgebase/support", "model/chat"),
+				spec("knowledgebase/support", "connector/docs", "model/embed"),
+				spec("model/embed"),
+				spec("model/chat", "finetuner/tune"),
+				spec("finetuner/tune", "connector/docs"),
+				spec("connector/docs"),
+			},
+			want: []string{
+				"connector/docs",
+				"finetuner/tune",
+				"model/chat",
+				"model/embed",
+				"knowledgebase/support",
+				"graph/qa",
+			},
+		},
+		{
+			// A connector built on a model is restored after it despite its kind
+			name: "dependency against the kind order",
+			resources: []*clusterproto.ResourceSpec{
+				spec("connector/summaries", "model/summarize"),
+				spec("model/summarize"),
+			},
+			want: []string{"model/summarize", "connector/summaries"},
+		},
+		{
+			name: "dependencies outside of the backup",
+			resources: []*clusterproto.ResourceSpec{
+				spec("graph/qa", "model/shared"),
+				spec("model/embed"),
+			},
+			want: []string{"model/embed", "graph/qa"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ordered, err := Order(tt.resources)
+			if err != nil {
+				t.Fatalf("Order() error = %v", err)
+			}
+			if got := refs(ordered); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Order() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderDoesNotModifyInput(t *testing.T) {
+	resources := []*clusterproto.ResourceSpec{spec("graph/qa", "model/a"), spec("model/a")}
+	if _, err := Order(resources); err != nil {
+		t.Fatalf("Order() error = %v", err)
+	}
+	if got := refs(resources); !reflect.DeepEqual(got, []string{"graph/qa", "model/a"}) {
+		t.Errorf("Order() reordered its input to %v", got)
+	}
+}
+
+func TestOrderCycle(t *testing.T) {
+	resources := []*clusterproto.ResourceSpec{
+		spec("model/a", "knowledgebase/kb"),
+		spec("knowledgebase/kb", "graph/g"),
+		spec("graph/g", "model/a"),
+	}
+
+	_, err := Order(resources)
+	if err == nil || !strings.Contains(err.Error(), "dependency cycle: model/a -> knowledgebase/kb -> graph/g -> model/a") {
+		t.Errorf("Order() error = %v, want the cycle", err)
+	}
+
+	if _, err := Order([]*clusterproto.ResourceSpec{spec("model/a", "model/a")}); err == nil || !strings.Contains(err.Error(), "dependency cycle") {
+		t.Errorf("Order() of a resource depending on itself error = %v", err)
+	}
+}
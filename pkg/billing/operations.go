@@ -0,0 +1,172 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	billingproto "github.com/nstreama-ai/nstream-ai-cli/proto/billing"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Usage grouping keys accepted by GetUsage
+const (
+	GroupByCluster      = "cluster"
+	GroupByResourceKind = "resource-kind"
+	GroupByDay          = "day"
+)
+
+// GroupByKeys lists the supported usage grouping keys
+var GroupByKeys = []string{GroupByCluster, GroupByResourceKind, GroupByDay}
+
+// Operations handles billing-related operations
+type Operations struct {
+	client *client.Client
+	config *config.Config
+}
+
+// NewOperationsWithClient creates an Operations instance that reuses an existing client and config
+func NewOperationsWithClient(c *client.Client, cfg *config.Config) *Operations {
+	return &Operations{
+		client: c,
+		config: cfg,
+	}
+}
+
+// CheckCredits checks whether the organization can pay for a cluster of the given tier.
+// clusterName is set when pricing a tier change of an existing cluster.
+func (o *Operations) CheckCredits(ctx context.Context, clusterType, cloudProvider, region, clusterName string) (*billingproto.CheckCreditsResponse, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	creditsResp, err := o.client.BillingClient.CheckCredits(ctx, &billingproto.CheckCreditsRequest{
+		AuthToken:     o.config.User.AuthToken,
+		ClusterType:   clusterType,
+		CloudProvider: cloudProvider,
+		Region:        region,
+		ClusterName:   clusterName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check credits: %v", err)
+	}
+
+	if creditsResp.Error != "" {
+		return nil, fmt.Errorf("failed to check credits: %s", creditsResp.Error)
+	}
+
+	return creditsResp, nil
+}
+
+// RequireCredits returns an error if the organization cannot pay for the given tier
+func (o *Operations) RequireCredits(ctx context.Context, clusterType, cloudProvider, region, clusterName string) (*billingproto.CheckCreditsResponse, error) {
+	creditsResp, err := o.CheckCredits(ctx, clusterType, cloudProvider, region, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !creditsResp.Sufficient {
+		return creditsResp, fmt.Errorf("insufficient credits for a %s cluster: %d credits/hour required, %d credits available",
+			clusterType, creditsResp.TargetCreditsPerHour, creditsResp.AvailableCredits)
+	}
+
+	return creditsResp, nil
+}
+
+// GetBalance returns the organization's available credits and current spend rate
+func (o *Operations) GetBalance(ctx context.Context) (*billingproto.GetBalanceResponse, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	balanceResp, err := o.client.BillingClient.GetBalance(ctx, &billingproto.GetBalanceRequest{
+		AuthToken: o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance: %v", err)
+	}
+
+	if balanceResp.Error != "" {
+		return nil, fmt.Errorf("failed to get balance: %s", balanceResp.Error)
+	}
+
+	return balanceResp, nil
+}
+
+// GetUsage returns credit usage between from and to, grouped by one of GroupByKeys
+func (o *Operations) GetUsage(ctx context.Context, from, to time.Time, groupBy string) (*billingproto.GetUsageResponse, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	usageResp, err := o.client.BillingClient.GetUsage(ctx, &billingproto.GetUsageRequest{
+		AuthToken: o.config.User.AuthToken,
+		From:      timestamppb.New(from),
+		To:        timestamppb.New(to),
+		GroupBy:   groupBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usage: %v", err)
+	}
+
+	if usageResp.Error != "" {
+		return nil, fmt.Errorf("failed to get usage: %s", usageResp.Error)
+	}
+
+	return usageResp, nil
+}
+
+// ListInvoices returns the organization's invoices
+func (o *Operations) ListInvoices(ctx context.Context) ([]*billingproto.Invoice, error) {
+	return o.IterateInvoices(client.ListOptions{}).All(ctx)
+}
+
+// IterateInvoices returns an iterator that fetches invoices one page at a time
+func (o *Operations) IterateInvoices(opts client.ListOptions) *client.Iterator[*billingproto.Invoice] {
+	return client.NewIterator(func(ctx context.Context, pageToken string, pageSize int32) ([]*billingproto.Invoice, string, error) {
+		if o.config.User.AuthToken == "" {
+			return nil, "", fmt.Errorf("authentication token is missing. Please sign in first")
+		}
+
+		invoicesResp, err := o.client.BillingClient.ListInvoices(ctx, &billingproto.ListInvoicesRequest{
+			PageSize:  pageSize,
+			PageToken: pageToken,
+			Filter:    opts.Filter,
+			OrderBy:   opts.OrderBy,
+			AuthToken: o.config.User.AuthToken,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to list invoices: %v", err)
+		}
+
+		if invoicesResp.Error != "" {
+			return nil, "", fmt.Errorf("failed to list invoices: %s", invoicesResp.Error)
+		}
+
+		return invoicesResp.Invoices, invoicesResp.NextPageToken, nil
+	}, opts)
+}
+
+// DownloadInvoice returns the rendered invoice document in the given format (pdf or csv)
+func (o *Operations) DownloadInvoice(ctx context.Context, invoiceID, format string) (*billingproto.DownloadInvoiceResponse, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	downloadResp, err := o.client.BillingClient.DownloadInvoice(ctx, &billingproto.DownloadInvoiceRequest{
+		AuthToken: o.config.User.AuthToken,
+		InvoiceId: invoiceID,
+		Format:    format,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download invoice: %v", err)
+	}
+
+	if downloadResp.Error != "" {
+		return nil, fmt.Errorf("failed to download invoice: %s", downloadResp.Error)
+	}
+
+	return downloadResp, nil
+}
@@ -0,0 +1,64 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	billingproto "github.com/nstreama-ai/nstream-ai-cli/proto/billing"
+)
+
+// Resources limited by quotas
+const (
+	QuotaClusters         = "clusters"
+	QuotaBuckets          = "buckets"
+	QuotaStreamGraphs     = "stream_graphs"
+	QuotaModelDeployments = "model_deployments"
+)
+
+// GetQuotas returns the organization's resource limits and current usage
+func (o *Operations) GetQuotas(ctx context.Context) ([]*billingproto.Quota, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	quotasResp, err := o.client.BillingClient.GetQuotas(ctx, &billingproto.GetQuotasRequest{
+		AuthToken: o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quotas: %v", err)
+	}
+
+	if quotasResp.Error != "" {
+		return nil, fmt.Errorf("failed to get quotas: %s", quotasResp.Error)
+	}
+
+	return quotasResp.Quotas, nil
+}
+
+// RequireQuota returns an error if the organization cannot create another resource of the given kind
+func (o *Operations) RequireQuota(ctx context.Context, resource string) error {
+	quotas, err := o.GetQuotas(ctx)
+	if err != nil {
+		return err
+	}
+
+	return CheckQuota(quotas, resource)
+}
+
+// CheckQuota returns an error if quotas leave no room for another resource of the given kind
+func CheckQuota(quotas []*billingproto.Quota, resource string) error {
+	for _, q := range quotas {
+		if q.Resource == resource && q.Limit > 0 && q.Used >= q.Limit {
+			return fmt.Errorf("quota exceeded: %d of %d %s in use. Delete unused %s or contact support to raise the limit (see 'nsai quota')",
+				q.Used, q.Limit, QuotaLabel(resource), QuotaLabel(resource))
+		}
+	}
+
+	return nil
+}
+
+// QuotaLabel returns the human-readable name of a quota resource
+func QuotaLabel(resource string) string {
+	return strings.ReplaceAll(resource, "_", " ")
+}
@@ -0,0 +1,29 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+// GetServiceRole returns the NStream identity to grant bucket access to on the cloud provider
+func (o *Operations) GetServiceRole(ctx context.Context, provider string) (*clusterproto.GetServiceRoleResponse, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	roleResp, err := o.client.BucketClient.GetServiceRole(ctx, &clusterproto.GetServiceRoleRequest{
+		CloudProvider: provider,
+		AuthToken:     o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service role: %v", err)
+	}
+
+	if roleResp.Error != "" {
+		return nil, fmt.Errorf("failed to get service role: %s", roleResp.Error)
+	}
+
+	return roleResp, nil
+}
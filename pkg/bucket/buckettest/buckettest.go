@@ -0,0 +1,312 @@
+// Package buckettest provides an in-memory object store behind an httptest.Server and a
+// BucketServiceClient that signs URLs for it, for testing object operations.
+package buckettest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+	"google.golang.org/grpc"
+)
+
+// SignatureHeader is sent with every signed URL and required by the server
+const SignatureHeader = "X-Nsai-Signature"
+
+// Server stores objects in memory and serves them at path-style signed URLs:
+// <server>/<bucket>/<key>. Multipart uploads store their parts until completed.
+type Server struct {
+	*httptest.Server
+
+	// FailPart makes uploads of this part number fail with 500 Internal Server Error
+	FailPart int32
+	// Delay holds every request for this long, to let uploads overlap
+	Delay time.Duration
+
+	mu        sync.Mutex
+	objects   map[string][]byte
+	uploads   map[string]map[int32][]byte
+	nextID    int
+	inFlight  int
+	maxFlight int
+	signed    []*clusterproto.SignObjectURLRequest
+	requests  []string
+	completed []*clusterproto.CompleteMultipartUploadRequest
+	aborted   []string
+}
+
+// NewServer starts a Server that is closed when the test ends
+func NewServer(t testing.TB) *Server {
+	s := &Server{
+		objects: map[string][]byte{},
+		uploads: map[string]map[int32][]byte{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Put stores an object
+func (s *Server) Put(bucketName, key string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[bucketName+"/"+key] = data
+}
+
+// Object returns the contents of an object and whether it exists
+func (s *Server) Object(bucketName, key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.objects[bucketName+"/"+key]
+	return data, ok
+}
+
+// MaxInFlight returns the largest number of requests served at the same time
+func (s *Server) MaxInFlight() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.maxFlight
+}
+
+// Signed returns the requests for signed URLs in the order they were received
+func (s *Server) Signed() []*clusterproto.SignObjectURLRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*clusterproto.SignObjectURLRequest(nil), s.signed...)
+}
+
+// Requests returns the method and escaped path of every request served, in order
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// Completed returns the requests that completed multipart uploads
+func (s *Server) Completed() []*clusterproto.CompleteMultipartUploadRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*clusterproto.CompleteMultipartUploadRequest(nil), s.completed...)
+}
+
+// Aborted returns the IDs of the aborted multipart uploads
+func (s *Server) Aborted() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.aborted...)
+}
+
+// PartETag is the ETag returned for a part of a multipart upload
+func PartETag(uploadID string, number int32) string {
+	return fmt.Sprintf("%q", fmt.Sprintf("%s-%d", uploadID, number))
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.inFlight++
+	s.maxFlight = max(s.maxFlight, s.inFlight)
+	s.requests = append(s.requests, r.Method+" "+r.URL.EscapedPath())
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.inFlight--
+		s.mu.Unlock()
+	}()
+
+	if s.Delay > 0 {
+		time.Sleep(s.Delay)
+	}
+
+	if r.Header.Get(SignatureHeader) != "valid" {
+		http.Error(w, "signature does not match", http.StatusForbidden)
+		return
+	}
+
+	bucketName, key, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if !ok || bucketName == "" || key == "" {
+		http.Error(w, "expected a path-style URL", http.StatusBadRequest)
+		return
+	}
+	name := bucketName + "/" + key
+
+	switch r.Method {
+	case http.MethodGet:
+		s.mu.Lock()
+		data, ok := s.objects[name]
+		s.mu.Unlock()
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Write(data)
+
+	case http.MethodPut:
+		// Like S3, signed uploads must give their length up front
+		if r.ContentLength < 0 {
+			http.Error(w, "length required", http.StatusLengthRequired)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		uploadID := r.URL.Query().Get("uploadId")
+		if uploadID == "" {
+			s.Put(bucketName, key, data)
+			return
+		}
+
+		number, _ := strconv.Atoi(r.URL.Query().Get("partNumber"))
+		if int32(number) == s.FailPart {
+			http.Error(w, "part failed", http.StatusInternalServerError)
+			return
+		}
+
+		s.mu.Lock()
+		parts, ok := s.uploads[uploadID]
+		if ok {
+			parts[int32(number)] = data
+		}
+		s.mu.Unlock()
+		if !ok {
+			http.Error(w, "no such upload", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("ETag", PartETag(uploadID, int32(number)))
+
+	case http.MethodDelete:
+		s.mu.Lock()
+		delete(s.objects, name)
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// Client returns a BucketServiceClient that signs URLs on the server. RPCs it does not
+// implement panic.
+func (s *Server) Client() clusterproto.BucketServiceClient {
+	return &bucketClient{server: s}
+}
+
+type bucketClient struct {
+	clusterproto.BucketServiceClient
+	server *Server
+}
+
+func (c *bucketClient) SignObjectURL(ctx context.Context, in *clusterproto.SignObjectURLRequest, opts ...grpc.CallOption) (*clusterproto.SignObjectURLResponse, error) {
+	s := c.server
+	s.mu.Lock()
+	s.signed = append(s.signed, in)
+	s.mu.Unlock()
+
+	if in.AuthToken == "" {
+		return &clusterproto.SignObjectURLResponse{Error: "unauthenticated"}, nil
+	}
+
+	query := url.Values{}
+	if in.UploadId != "" {
+		query.Set("uploadId", in.UploadId)
+		query.Set("partNumber", strconv.Itoa(int(in.PartNumber)))
+	}
+	// Keys keep their slashes, like the path-style URLs of S3-compatible servers
+	segments := strings.Split(in.Key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	u := s.URL + "/" + in.Bucket + "/" + strings.Join(segments, "/")
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	return &clusterproto.SignObjectURLResponse{
+		Url:     u,
+		Headers: map[string]string{SignatureHeader: "valid"},
+	}, nil
+}
+
+func (c *bucketClient) ListObjects(ctx context.Context, in *clusterproto.ListObjectsRequest, opts ...grpc.CallOption) (*clusterproto.ListObjectsResponse, error) {
+	s := c.server
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var keys []string
+	for name := range s.objects {
+		bucketName, key, _ := strings.Cut(name, "/")
+		if bucketName == in.Bucket && strings.HasPrefix(key, in.Prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	resp := &clusterproto.ListObjectsResponse{}
+	for _, key := range keys {
+		resp.Objects = append(resp.Objects, &clusterproto.Object{
+			Key:  key,
+			Size: int64(len(s.objects[in.Bucket+"/"+key])),
+		})
+	}
+	return resp, nil
+}
+
+func (c *bucketClient) StartMultipartUpload(ctx context.Context, in *clusterproto.StartMultipartUploadRequest, opts ...grpc.CallOption) (*clusterproto.StartMultipartUploadResponse, error) {
+	s := c.server
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	uploadID := fmt.Sprintf("upload-%d", s.nextID)
+	s.uploads[uploadID] = map[int32][]byte{}
+	return &clusterproto.StartMultipartUploadResponse{UploadId: uploadID}, nil
+}
+
+func (c *bucketClient) CompleteMultipartUpload(ctx context.Context, in *clusterproto.CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*clusterproto.CompleteMultipartUploadResponse, error) {
+	s := c.server
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.completed = append(s.completed, in)
+	parts, ok := s.uploads[in.UploadId]
+	if !ok {
+		return &clusterproto.CompleteMultipartUploadResponse{Error: "no such upload"}, nil
+	}
+
+	var data []byte
+	for _, part := range in.Parts {
+		if part.Etag != PartETag(in.UploadId, part.PartNumber) {
+			return &clusterproto.CompleteMultipartUploadResponse{Error: fmt.Sprintf("invalid ETag for part %d", part.PartNumber)}, nil
+		}
+		data = append(data, parts[part.PartNumber]...)
+	}
+	delete(s.uploads, in.UploadId)
+	s.objects[in.Bucket+"/"+in.Key] = data
+
+	return &clusterproto.CompleteMultipartUploadResponse{
+		Object: &clusterproto.Object{Key: in.Key, Size: int64(len(data))},
+	}, nil
+}
+
+func (c *bucketClient) AbortMultipartUpload(ctx context.Context, in *clusterproto.AbortMultipartUploadRequest, opts ...grpc.CallOption) (*clusterproto.AbortMultipartUploadResponse, error) {
+	s := c.server
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.aborted = append(s.aborted, in.UploadId)
+	delete(s.uploads, in.UploadId)
+	return &clusterproto.AbortMultipartUploadResponse{}, nil
+}
@@ -0,0 +1,198 @@
+package bucket
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+// PathScheme optionally prefixes bucket paths to tell them apart from local files
+const PathScheme = "bucket://"
+
+// ParsePath splits a "[bucket://]<bucket>/<key>" path into its bucket and key
+func ParsePath(path string) (string, string, error) {
+	bucketName, key, _ := strings.Cut(strings.TrimPrefix(path, PathScheme), "/")
+	if bucketName == "" {
+		return "", "", fmt.Errorf("invalid bucket path %q (expected <bucket>/<key>)", path)
+	}
+	return bucketName, key, nil
+}
+
+// SignObjectURL requests a short-lived signed URL for the given object and HTTP method
+func (o *Operations) SignObjectURL(ctx context.Context, bucketName, key, method string) (*clusterproto.SignObjectURLResponse, error) {
+	return o.signURL(ctx, &clusterproto.SignObjectURLRequest{
+		Bucket: bucketName,
+		Key:    key,
+		Method: method,
+	})
+}
+
+func (o *Operations) signURL(ctx context.Context, req *clusterproto.SignObjectURLRequest) (*clusterproto.SignObjectURLResponse, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	req.AuthToken = o.config.User.AuthToken
+	signResp, err := o.client.BucketClient.SignObjectURL(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign object URL: %v", err)
+	}
+
+	if signResp.Error != "" {
+		return nil, fmt.Errorf("failed to sign object URL: %s", signResp.Error)
+	}
+
+	return signResp, nil
+}
+
+// do sends a request to a signed URL and returns the response if it succeeded.
+// The caller must close the response body.
+func (o *Operations) do(ctx context.Context, signed *clusterproto.SignObjectURLResponse, method string, body io.Reader, size int64) (*http.Response, error) {
+	// net/http sends a non-nil body of length 0 chunked, which signed PUTs reject
+	if body != nil && size == 0 {
+		body = http.NoBody
+	}
+	req, err := http.NewRequestWithContext(ctx, method, signed.Url, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.ContentLength = size
+	}
+	for k, v := range signed.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := o.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode/100 != 2 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s", resp.Status)
+	}
+	return resp, nil
+}
+
+// Progress receives the progress of a transfer. Add may be called concurrently.
+type Progress interface {
+	// SetTotal sets the size of the transfer in bytes, 0 if unknown
+	SetTotal(total int64)
+	// Add records n more transferred bytes
+	Add(n int64)
+}
+
+// progressWriter reports the bytes written through it
+type progressWriter struct {
+	progress Progress
+}
+
+func (w progressWriter) Write(p []byte) (int, error) {
+	w.progress.Add(int64(len(p)))
+	return len(p), nil
+}
+
+// PutObject uploads data to an object through a signed URL
+func (o *Operations) PutObject(ctx context.Context, bucketName, key string, data []byte) error {
+	signed, err := o.SignObjectURL(ctx, bucketName, key, http.MethodPut)
+	if err != nil {
+		return err
+	}
+
+	resp, err := o.do(ctx, signed, http.MethodPut, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return fmt.Errorf("failed to upload %s/%s: %v", bucketName, key, err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// GetObject downloads an object through a signed URL
+func (o *Operations) GetObject(ctx context.Context, bucketName, key string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := o.DownloadObject(ctx, bucketName, key, &buf, nil); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DownloadObject streams an object to w through a signed URL, reporting to progress if set
+func (o *Operations) DownloadObject(ctx context.Context, bucketName, key string, w io.Writer, progress Progress) error {
+	signed, err := o.SignObjectURL(ctx, bucketName, key, http.MethodGet)
+	if err != nil {
+		return err
+	}
+
+	resp, err := o.do(ctx, signed, http.MethodGet, nil, 0)
+	if err != nil {
+		return fmt.Errorf("failed to download %s/%s: %v", bucketName, key, err)
+	}
+	defer resp.Body.Close()
+
+	if progress != nil {
+		progress.SetTotal(max(resp.ContentLength, 0))
+		w = io.MultiWriter(w, progressWriter{progress})
+	}
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("failed to download %s/%s: %v", bucketName, key, err)
+	}
+	return nil
+}
+
+// DeleteObject deletes an object through a signed URL
+func (o *Operations) DeleteObject(ctx context.Context, bucketName, key string) error {
+	signed, err := o.SignObjectURL(ctx, bucketName, key, http.MethodDelete)
+	if err != nil {
+		return err
+	}
+
+	resp, err := o.do(ctx, signed, http.MethodDelete, nil, 0)
+	if err != nil {
+		return fmt.Errorf("failed to delete %s/%s: %v", bucketName, key, err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// IterateObjects returns an iterator over the objects under prefix, fetched one page at a time.
+// With a delimiter, keys are not listed recursively: each group of keys sharing a prefix up to
+// the delimiter is returned once as an object whose key ends with the delimiter and has no size.
+func (o *Operations) IterateObjects(bucketName, prefix, delimiter string, opts client.ListOptions) *client.Iterator[*clusterproto.Object] {
+	return client.NewIterator(func(ctx context.Context, pageToken string, pageSize int32) ([]*clusterproto.Object, string, error) {
+		if o.config.User.AuthToken == "" {
+			return nil, "", fmt.Errorf("authentication token is missing. Please sign in first")
+		}
+
+		listResp, err := o.client.BucketClient.ListObjects(ctx, &clusterproto.ListObjectsRequest{
+			Bucket:    bucketName,
+			Prefix:    prefix,
+			Delimiter: delimiter,
+			PageSize:  pageSize,
+			PageToken: pageToken,
+			AuthToken: o.config.User.AuthToken,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to list objects: %v", err)
+		}
+
+		if listResp.Error != "" {
+			return nil, "", fmt.Errorf("failed to list objects: %s", listResp.Error)
+		}
+
+		objects := make([]*clusterproto.Object, 0, len(listResp.CommonPrefixes)+len(listResp.Objects))
+		for _, p := range listResp.CommonPrefixes {
+			objects = append(objects, &clusterproto.Object{Key: p})
+		}
+		objects = append(objects, listResp.Objects...)
+
+		return objects, listResp.NextPageToken, nil
+	}, opts)
+}
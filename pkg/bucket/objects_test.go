@@ -0,0 +1,230 @@
+package bucket
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/bucket/buckettest"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+	"google.golang.org/grpc"
+)
+
+// newTestOperations returns Operations that talk to an in-memory object store
+func newTestOperations(t *testing.T) (*Operations, *buckettest.Server) {
+	t.Helper()
+
+	srv := buckettest.NewServer(t)
+	cfg := &config.Config{User: config.UserConfig{AuthToken: "token"}}
+	ops := NewOperationsWithClient(&client.Client{BucketClient: srv.Client()}, cfg)
+	ops.http = srv.Server.Client()
+	return ops, srv
+}
+
+// countingProgress records what a transfer reports
+type countingProgress struct {
+	total int64
+	added atomic.Int64
+}
+
+func (p *countingProgress) SetTotal(total int64) { p.total = total }
+func (p *countingProgress) Add(n int64)          { p.added.Add(n) }
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		path       string
+		bucketName string
+		key        string
+		wantErr    bool
+	}{
+		{path: "bucket://data/a/b.txt", bucketName: "data", key: "a/b.txt"},
+		{path: "data/a/", bucketName: "data", key: "a/"},
+		{path: "bucket://data", bucketName: "data", key: ""},
+		{path: "bucket:///a", wantErr: true},
+		{path: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		bucketName, key, err := ParsePath(tt.path)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePath(%q) error = %v, wantErr %v", tt.path, err, tt.wantErr)
+			continue
+		}
+		if bucketName != tt.bucketName || key != tt.key {
+			t.Errorf("ParsePath(%q) = %q, %q, want %q, %q", tt.path, bucketName, key, tt.bucketName, tt.key)
+		}
+	}
+}
+
+func TestSignObjectURL(t *testing.T) {
+	ops, srv := newTestOperations(t)
+
+	signed, err := ops.SignObjectURL(context.Background(), "data", "a/b.txt", http.MethodGet)
+	if err != nil {
+		t.Fatalf("SignObjectURL() error = %v", err)
+	}
+	if !strings.HasPrefix(signed.Url, srv.URL+"/data/") {
+		t.Errorf("SignObjectURL() URL = %q, want a URL on %s", signed.Url, srv.URL)
+	}
+
+	reqs := srv.Signed()
+	if len(reqs) != 1 {
+		t.Fatalf("got %d sign requests, want 1", len(reqs))
+	}
+	req := reqs[0]
+	if req.Bucket != "data" || req.Key != "a/b.txt" || req.Method != http.MethodGet || req.AuthToken != "token" {
+		t.Errorf("sign request = %v, want data/a/b.txt GET with the auth token", req)
+	}
+}
+
+func TestSignObjectURLErrors(t *testing.T) {
+	ops, _ := newTestOperations(t)
+	ops.config.User.AuthToken = ""
+
+	if _, err := ops.SignObjectURL(context.Background(), "data", "a", http.MethodGet); err == nil || !strings.Contains(err.Error(), "sign in") {
+		t.Errorf("SignObjectURL() without a token error = %v, want a sign in error", err)
+	}
+
+	// The server's error is returned when it refuses to sign
+	ops.client.BucketClient = errorSigner{ops.client.BucketClient}
+	ops.config.User.AuthToken = "token"
+	if _, err := ops.SignObjectURL(context.Background(), "data", "a", http.MethodGet); err == nil || !strings.Contains(err.Error(), "bucket not found") {
+		t.Errorf("SignObjectURL() error = %v, want the server's error", err)
+	}
+}
+
+// errorSigner refuses to sign any URL
+type errorSigner struct {
+	clusterproto.BucketServiceClient
+}
+
+func (errorSigner) SignObjectURL(context.Context, *clusterproto.SignObjectURLRequest, ...grpc.CallOption) (*clusterproto.SignObjectURLResponse, error) {
+	return &clusterproto.SignObjectURLResponse{Error: "bucket not found"}, nil
+}
+
+func TestDo(t *testing.T) {
+	ops, srv := newTestOperations(t)
+	srv.Put("data", "a.txt", []byte("hello"))
+	ctx := context.Background()
+
+	signed, err := ops.SignObjectURL(ctx, "data", "a.txt", http.MethodGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := ops.do(ctx, signed, http.MethodGet, nil, 0)
+	if err != nil {
+		t.Fatalf("do() error = %v", err)
+	}
+	resp.Body.Close()
+
+	// The signed headers are part of the signature
+	delete(signed.Headers, buckettest.SignatureHeader)
+	if _, err := ops.do(ctx, signed, http.MethodGet, nil, 0); err == nil || !strings.Contains(err.Error(), "403") {
+		t.Errorf("do() without the signed headers error = %v, want 403 Forbidden", err)
+	}
+
+	signed, err = ops.SignObjectURL(ctx, "data", "missing.txt", http.MethodGet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ops.do(ctx, signed, http.MethodGet, nil, 0); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("do() of a missing object error = %v, want 404 Not Found", err)
+	}
+}
+
+func TestDownloadObject(t *testing.T) {
+	ops, srv := newTestOperations(t)
+	data := bytes.Repeat([]byte("0123456789"), 1000)
+	srv.Put("data", "dir/obj.bin", data)
+
+	var (
+		buf      bytes.Buffer
+		progress countingProgress
+	)
+	if err := ops.DownloadObject(context.Background(), "data", "dir/obj.bin", &buf, &progress); err != nil {
+		t.Fatalf("DownloadObject() error = %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), data) {
+		t.Errorf("DownloadObject() wrote %d bytes, want the %d bytes of the object", buf.Len(), len(data))
+	}
+	if progress.total != int64(len(data)) || progress.added.Load() != int64(len(data)) {
+		t.Errorf("progress = %d of %d, want %d of %d", progress.added.Load(), progress.total, len(data), len(data))
+	}
+
+	err := ops.DownloadObject(context.Background(), "data", "missing", &buf, nil)
+	if err == nil || !strings.Contains(err.Error(), "failed to download data/missing") {
+		t.Errorf("DownloadObject() of a missing object error = %v, want a download error", err)
+	}
+}
+
+func TestPutAndDeleteObject(t *testing.T) {
+	ops, srv := newTestOperations(t)
+	ctx := context.Background()
+
+	if err := ops.PutObject(ctx, "data", "a.txt", []byte("hello")); err != nil {
+		t.Fatalf("PutObject() error = %v", err)
+	}
+	if got, _ := srv.Object("data", "a.txt"); string(got) != "hello" {
+		t.Errorf("stored object = %q, want %q", got, "hello")
+	}
+
+	got, err := ops.GetObject(ctx, "data", "a.txt")
+	if err != nil || string(got) != "hello" {
+		t.Errorf("GetObject() = %q, %v, want %q", got, err, "hello")
+	}
+
+	if err := ops.DeleteObject(ctx, "data", "a.txt"); err != nil {
+		t.Fatalf("DeleteObject() error = %v", err)
+	}
+	if _, ok := srv.Object("data", "a.txt"); ok {
+		t.Error("object still exists after DeleteObject()")
+	}
+}
+
+// TestObjectOperationsPathStyle runs object operations against a stand-in for an
+// S3-compatible server that only accepts path-style URLs, <endpoint>/<bucket>/<key>
+func TestObjectOperationsPathStyle(t *testing.T) {
+	ops, srv := newTestOperations(t)
+	ctx := context.Background()
+	key := "reports/2024 Q1/summary+final.csv"
+	path := "/data/reports/2024%20Q1/summary+final.csv"
+
+	data := testData(2*MinPartSize + 1)
+	if err := ops.UploadObject(ctx, "data", key, bytes.NewReader(data), int64(len(data)), UploadOptions{PartSize: MinPartSize}); err != nil {
+		t.Fatalf("UploadObject() error = %v", err)
+	}
+	if err := ops.PutObject(ctx, "data", "small.txt", []byte("small")); err != nil {
+		t.Fatalf("PutObject() error = %v", err)
+	}
+
+	got, err := ops.GetObject(ctx, "data", key)
+	if err != nil {
+		t.Fatalf("GetObject() error = %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("GetObject() = %d bytes, want the %d bytes uploaded", len(got), len(data))
+	}
+
+	objects, err := ops.IterateObjects("data", "reports/", "", client.ListOptions{}).All(ctx)
+	if err != nil {
+		t.Fatalf("IterateObjects() error = %v", err)
+	}
+	if len(objects) != 1 || objects[0].Key != key {
+		t.Errorf("IterateObjects() = %v, want %s", objects, key)
+	}
+
+	if err := ops.DeleteObject(ctx, "data", key); err != nil {
+		t.Fatalf("DeleteObject() error = %v", err)
+	}
+
+	// Every request went to the signed path on the endpoint, without the bucket in the host
+	want := []string{"PUT " + path, "PUT " + path, "PUT " + path, "PUT /data/small.txt", "GET " + path, "DELETE " + path}
+	if requests := srv.Requests(); strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("requests =\n%s\nwant\n%s", strings.Join(requests, "\n"), strings.Join(want, "\n"))
+	}
+}
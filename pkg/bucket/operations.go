@@ -0,0 +1,144 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Operations handles bucket-related operations
+type Operations struct {
+	client *client.Client
+	config *config.Config
+	http   *http.Client
+}
+
+// NewOperationsWithClient creates an Operations instance that reuses an existing client and config
+func NewOperationsWithClient(c *client.Client, cfg *config.Config) *Operations {
+	return &Operations{
+		client: c,
+		config: cfg,
+		http:   http.DefaultClient,
+	}
+}
+
+// ListBuckets lists the buckets of a cloud provider, or of every provider when cloudProvider is
+// empty. A non-nil selector is sent to the server so only buckets with matching labels are returned.
+func (o *Operations) ListBuckets(ctx context.Context, cloudProvider string, selector *clusterproto.LabelSelector) ([]*clusterproto.Bucket, error) {
+	return o.IterateBuckets(cloudProvider, client.ListOptions{Selector: selector}).All(ctx)
+}
+
+// IterateBuckets returns an iterator that fetches buckets one page at a time
+func (o *Operations) IterateBuckets(cloudProvider string, opts client.ListOptions) *client.Iterator[*clusterproto.Bucket] {
+	return client.NewIterator(func(ctx context.Context, pageToken string, pageSize int32) ([]*clusterproto.Bucket, string, error) {
+		if o.config.User.AuthToken == "" {
+			return nil, "", fmt.Errorf("authentication token is missing. Please sign in first")
+		}
+
+		listResp, err := o.client.BucketClient.ListBuckets(ctx, &clusterproto.ListBucketsRequest{
+			CloudProvider: cloudProvider,
+			Selector:      opts.Selector,
+			PageSize:      pageSize,
+			PageToken:     pageToken,
+			Filter:        opts.Filter,
+			OrderBy:       opts.OrderBy,
+			AuthToken:     o.config.User.AuthToken,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to list buckets: %v", err)
+		}
+
+		return listResp.Buckets, listResp.NextPageToken, nil
+	}, opts)
+}
+
+// CreateBucket creates a new bucket
+func (o *Operations) CreateBucket(ctx context.Context, req *clusterproto.CreateBucketRequest) (*clusterproto.Bucket, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	req.AuthToken = o.config.User.AuthToken
+	createResp, err := o.client.BucketClient.CreateBucket(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bucket: %v", err)
+	}
+
+	if createResp.Error != "" {
+		return nil, fmt.Errorf("failed to create bucket: %s", createResp.Error)
+	}
+
+	return createResp.Bucket, nil
+}
+
+// GetBucket gets a single bucket by name
+func (o *Operations) GetBucket(ctx context.Context, bucketName string) (*clusterproto.Bucket, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	getResp, err := o.client.BucketClient.GetBucket(ctx, &clusterproto.GetBucketRequest{
+		BucketName: bucketName,
+		AuthToken:  o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bucket: %v", err)
+	}
+
+	if getResp.Error != "" {
+		return nil, fmt.Errorf("failed to get bucket: %s", getResp.Error)
+	}
+
+	return getResp.Bucket, nil
+}
+
+// DeleteBucket deletes a bucket. Unless deleteObjects is set the server refuses to delete a
+// bucket that still contains objects.
+func (o *Operations) DeleteBucket(ctx context.Context, bucketName string, deleteObjects bool) error {
+	if o.config.User.AuthToken == "" {
+		return fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	deleteResp, err := o.client.BucketClient.DeleteBucket(ctx, &clusterproto.DeleteBucketRequest{
+		BucketName:    bucketName,
+		DeleteObjects: deleteObjects,
+		AuthToken:     o.config.User.AuthToken,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete bucket: %v", err)
+	}
+
+	if deleteResp.Error != "" {
+		return fmt.Errorf("failed to delete bucket: %s", deleteResp.Error)
+	}
+
+	return nil
+}
+
+// UpdateBucket updates the fields of a bucket named in updateMask
+func (o *Operations) UpdateBucket(ctx context.Context, bucketName string, b *clusterproto.Bucket, updateMask []string) (*clusterproto.Bucket, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	updateResp, err := o.client.BucketClient.UpdateBucket(ctx, &clusterproto.UpdateBucketRequest{
+		BucketName: bucketName,
+		Bucket:     b,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: updateMask},
+		AuthToken:  o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update bucket: %v", err)
+	}
+
+	if updateResp.Error != "" {
+		return nil, fmt.Errorf("failed to update bucket: %s", updateResp.Error)
+	}
+
+	return updateResp.Bucket, nil
+}
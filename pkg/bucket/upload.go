@@ -0,0 +1,217 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+const (
+	// DefaultPartSize is the size of each part of a multipart upload
+	DefaultPartSize = 16 << 20
+	// MinPartSize is the smallest part size accepted by every provider
+	MinPartSize = 5 << 20
+	// DefaultConcurrency is the number of parts uploaded in parallel
+	DefaultConcurrency = 4
+
+	// maxParts is the largest number of parts in a multipart upload
+	maxParts = 10000
+)
+
+// UploadOptions control how an object is uploaded
+type UploadOptions struct {
+	// PartSize is the size of each part; objects no larger than one part are uploaded with a single PUT
+	PartSize int64
+	// Concurrency is the number of parts uploaded in parallel
+	Concurrency int
+	ContentType string
+	Progress    Progress
+}
+
+// UploadObject uploads size bytes from r to an object. Objects larger than one part are sent as a
+// multipart upload with parts uploaded in parallel; a failed multipart upload is aborted.
+func (o *Operations) UploadObject(ctx context.Context, bucketName, key string, r io.ReaderAt, size int64, opts UploadOptions) error {
+	if opts.PartSize <= 0 {
+		opts.PartSize = DefaultPartSize
+	}
+	if opts.PartSize < MinPartSize {
+		return fmt.Errorf("part size must be at least %d bytes", MinPartSize)
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultConcurrency
+	}
+	// Grow the parts of very large objects to stay within the part limit
+	for size/opts.PartSize >= maxParts {
+		opts.PartSize *= 2
+	}
+
+	if opts.Progress != nil {
+		opts.Progress.SetTotal(size)
+	}
+
+	if size <= opts.PartSize {
+		signed, err := o.SignObjectURL(ctx, bucketName, key, http.MethodPut)
+		if err != nil {
+			return err
+		}
+		resp, err := o.do(ctx, signed, http.MethodPut, partReader(r, 0, size, opts.Progress), size)
+		if err != nil {
+			return fmt.Errorf("failed to upload %s/%s: %v", bucketName, key, err)
+		}
+		resp.Body.Close()
+		return nil
+	}
+
+	return o.uploadMultipart(ctx, bucketName, key, r, size, opts)
+}
+
+func (o *Operations) uploadMultipart(ctx context.Context, bucketName, key string, r io.ReaderAt, size int64, opts UploadOptions) error {
+	if o.config.User.AuthToken == "" {
+		return fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	startResp, err := o.client.BucketClient.StartMultipartUpload(ctx, &clusterproto.StartMultipartUploadRequest{
+		Bucket:      bucketName,
+		Key:         key,
+		ContentType: opts.ContentType,
+		AuthToken:   o.config.User.AuthToken,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start upload of %s/%s: %v", bucketName, key, err)
+	}
+	if startResp.Error != "" {
+		return fmt.Errorf("failed to start upload of %s/%s: %s", bucketName, key, startResp.Error)
+	}
+	uploadID := startResp.UploadId
+
+	parts, err := o.uploadParts(ctx, bucketName, key, uploadID, r, size, opts)
+	if err != nil {
+		o.abortMultipart(bucketName, key, uploadID)
+		return err
+	}
+
+	completeResp, err := o.client.BucketClient.CompleteMultipartUpload(ctx, &clusterproto.CompleteMultipartUploadRequest{
+		Bucket:    bucketName,
+		Key:       key,
+		UploadId:  uploadID,
+		Parts:     parts,
+		AuthToken: o.config.User.AuthToken,
+	})
+	if err != nil {
+		o.abortMultipart(bucketName, key, uploadID)
+		return fmt.Errorf("failed to complete upload of %s/%s: %v", bucketName, key, err)
+	}
+	if completeResp.Error != "" {
+		o.abortMultipart(bucketName, key, uploadID)
+		return fmt.Errorf("failed to complete upload of %s/%s: %s", bucketName, key, completeResp.Error)
+	}
+
+	return nil
+}
+
+// uploadParts uploads every part with up to opts.Concurrency uploads in flight and returns
+// the completed parts in order
+func (o *Operations) uploadParts(ctx context.Context, bucketName, key, uploadID string, r io.ReaderAt, size int64, opts UploadOptions) ([]*clusterproto.CompletedPart, error) {
+	count := int((size + opts.PartSize - 1) / opts.PartSize)
+	parts := make([]*clusterproto.CompletedPart, count)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, opts.Concurrency)
+
+	for i := 0; i < count; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			offset := int64(i) * opts.PartSize
+			length := min(opts.PartSize, size-offset)
+			number := int32(i + 1)
+
+			etag, err := o.uploadPart(ctx, bucketName, key, uploadID, number, partReader(r, offset, length, opts.Progress), length)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = fmt.Errorf("failed to upload part %d of %s/%s: %v", number, bucketName, key, err)
+				}
+				mu.Unlock()
+				cancel()
+				return
+			}
+			parts[i] = &clusterproto.CompletedPart{PartNumber: number, Etag: etag}
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
+
+func (o *Operations) uploadPart(ctx context.Context, bucketName, key, uploadID string, number int32, body io.Reader, length int64) (string, error) {
+	signed, err := o.signURL(ctx, &clusterproto.SignObjectURLRequest{
+		Bucket:     bucketName,
+		Key:        key,
+		Method:     http.MethodPut,
+		UploadId:   uploadID,
+		PartNumber: number,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := o.do(ctx, signed, http.MethodPut, body, length)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+
+	return resp.Header.Get("ETag"), nil
+}
+
+// abortMultipart discards an unfinished upload. It uses its own context so that uploads
+// cancelled with Ctrl-C are still cleaned up, and failures are ignored since the server
+// expires abandoned uploads.
+func (o *Operations) abortMultipart(bucketName, key, uploadID string) {
+	ctx, cancel := o.client.WithContext(context.Background())
+	defer cancel()
+
+	o.client.BucketClient.AbortMultipartUpload(ctx, &clusterproto.AbortMultipartUploadRequest{
+		Bucket:    bucketName,
+		Key:       key,
+		UploadId:  uploadID,
+		AuthToken: o.config.User.AuthToken,
+	})
+}
+
+// partReader returns a reader over length bytes of r starting at offset that reports progress
+func partReader(r io.ReaderAt, offset, length int64, progress Progress) io.Reader {
+	var part io.Reader = io.NewSectionReader(r, offset, length)
+	if progress != nil {
+		part = io.TeeReader(part, progressWriter{progress})
+	}
+	return part
+}
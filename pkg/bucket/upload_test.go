@@ -0,0 +1,125 @@
+package bucket
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/bucket/buckettest"
+)
+
+// testData returns size bytes that differ from part to part
+func testData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i / 1021)
+	}
+	return data
+}
+
+func TestUploadObjectSinglePut(t *testing.T) {
+	ops, srv := newTestOperations(t)
+	data := testData(1 << 20)
+
+	var progress countingProgress
+	err := ops.UploadObject(context.Background(), "data", "small.bin", bytes.NewReader(data), int64(len(data)), UploadOptions{Progress: &progress})
+	if err != nil {
+		t.Fatalf("UploadObject() error = %v", err)
+	}
+
+	if got, _ := srv.Object("data", "small.bin"); !bytes.Equal(got, data) {
+		t.Errorf("stored %d bytes, want the %d bytes uploaded", len(got), len(data))
+	}
+	if len(srv.Completed()) != 0 {
+		t.Error("an object no larger than one part was uploaded as a multipart upload")
+	}
+	if progress.total != int64(len(data)) || progress.added.Load() != int64(len(data)) {
+		t.Errorf("progress = %d of %d, want %d of %d", progress.added.Load(), progress.total, len(data), len(data))
+	}
+}
+
+func TestUploadObjectEmpty(t *testing.T) {
+	ops, srv := newTestOperations(t)
+
+	if err := ops.UploadObject(context.Background(), "data", "empty.txt", bytes.NewReader(nil), 0, UploadOptions{}); err != nil {
+		t.Fatalf("UploadObject() of an empty file error = %v", err)
+	}
+	if got, ok := srv.Object("data", "empty.txt"); !ok || len(got) != 0 {
+		t.Errorf("stored %q, %v, want an empty object", got, ok)
+	}
+}
+
+func TestUploadObjectMultipart(t *testing.T) {
+	ops, srv := newTestOperations(t)
+	srv.Delay = 20 * time.Millisecond
+
+	// Five parts, the last one short
+	data := testData(4*MinPartSize + 1234)
+	opts := UploadOptions{PartSize: MinPartSize, Concurrency: 2}
+
+	if err := ops.UploadObject(context.Background(), "data", "big.bin", bytes.NewReader(data), int64(len(data)), opts); err != nil {
+		t.Fatalf("UploadObject() error = %v", err)
+	}
+
+	completed := srv.Completed()
+	if len(completed) != 1 {
+		t.Fatalf("got %d completed uploads, want 1", len(completed))
+	}
+	parts := completed[0].Parts
+	if len(parts) != 5 {
+		t.Fatalf("got %d parts, want 5", len(parts))
+	}
+	for i, part := range parts {
+		number := int32(i + 1)
+		if part.PartNumber != number || part.Etag != buckettest.PartETag(completed[0].UploadId, number) {
+			t.Errorf("part %d = %d %s, want %d with the ETag returned for it", i, part.PartNumber, part.Etag, number)
+		}
+	}
+
+	// The server joins the parts in the order given, so the object only matches when the
+	// parts were split at the right offsets
+	if got, _ := srv.Object("data", "big.bin"); !bytes.Equal(got, data) {
+		t.Errorf("stored %d bytes that differ from the %d bytes uploaded", len(got), len(data))
+	}
+
+	if n := srv.MaxInFlight(); n != opts.Concurrency {
+		t.Errorf("%d parts were uploaded at once, want %d", n, opts.Concurrency)
+	}
+	if aborted := srv.Aborted(); len(aborted) != 0 {
+		t.Errorf("aborted %v after a successful upload", aborted)
+	}
+}
+
+func TestUploadObjectAbortsFailedPart(t *testing.T) {
+	ops, srv := newTestOperations(t)
+	srv.FailPart = 2
+
+	data := testData(3 * MinPartSize)
+	opts := UploadOptions{PartSize: MinPartSize, Concurrency: 1}
+
+	err := ops.UploadObject(context.Background(), "data", "big.bin", bytes.NewReader(data), int64(len(data)), opts)
+	if err == nil || !strings.Contains(err.Error(), "failed to upload part 2 of data/big.bin") {
+		t.Fatalf("UploadObject() error = %v, want part 2 to fail", err)
+	}
+
+	if aborted := srv.Aborted(); len(aborted) != 1 {
+		t.Errorf("aborted %v, want the failed upload", aborted)
+	}
+	if len(srv.Completed()) != 0 {
+		t.Error("a failed upload was completed")
+	}
+	if _, ok := srv.Object("data", "big.bin"); ok {
+		t.Error("a failed upload created the object")
+	}
+}
+
+func TestUploadObjectPartSize(t *testing.T) {
+	ops, _ := newTestOperations(t)
+
+	err := ops.UploadObject(context.Background(), "data", "a", bytes.NewReader(nil), 0, UploadOptions{PartSize: MinPartSize - 1})
+	if err == nil || !strings.Contains(err.Error(), "part size must be at least") {
+		t.Errorf("UploadObject() error = %v, want a part size error", err)
+	}
+}
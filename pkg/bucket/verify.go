@@ -0,0 +1,150 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/provider"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+// Bucket access check statuses
+const (
+	CheckPassed  = "passed"
+	CheckFailed  = "failed"
+	CheckSkipped = "skipped"
+)
+
+// checkLabels are the human-readable names of bucket access checks
+var checkLabels = map[string]string{
+	provider.CheckEndpoint:   "Reach endpoint",
+	provider.CheckAssumeRole: "Assume access role",
+	provider.CheckList:       "List objects",
+	provider.CheckGet:        "Read objects",
+	provider.CheckPut:        "Write objects",
+	provider.CheckDelete:     "Delete objects",
+	provider.CheckKMSDecrypt: "Decrypt with KMS key",
+	provider.CheckKMSKey:     "Use customer-managed key",
+}
+
+// CheckLabel returns the human-readable name of a bucket access check
+func CheckLabel(name string) string {
+	if label, ok := checkLabels[name]; ok {
+		return label
+	}
+	return name
+}
+
+// VerifyAccess checks that NStream can use the bucket through the access role. A failed
+// verification is not an error: the response lists which checks failed.
+func (o *Operations) VerifyAccess(ctx context.Context, provider, bucketName, role string) (*clusterproto.VerifyBucketAccessResponse, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	accessResp, err := o.client.BucketClient.VerifyBucketAccess(ctx, &clusterproto.VerifyBucketAccessRequest{
+		CloudProvider: provider,
+		Bucket:        bucketName,
+		Role:          role,
+		AuthToken:     o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify bucket access: %v", err)
+	}
+
+	return accessResp, nil
+}
+
+// CheckReadiness checks that the bucket and the resources a cluster needs are ready. An
+// empty kmsKey checks the bucket's own key, if it has one. Resources that are not ready are
+// not an error: the response lists which checks failed.
+func (o *Operations) CheckReadiness(ctx context.Context, provider, bucketName, role, kmsKey string) (*clusterproto.CheckResourceReadinessResponse, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	readyResp, err := o.client.BucketClient.CheckResourceReadiness(ctx, &clusterproto.CheckResourceReadinessRequest{
+		CloudProvider: provider,
+		Bucket:        bucketName,
+		Role:          role,
+		KmsKey:        kmsKey,
+		AuthToken:     o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check resource readiness: %v", err)
+	}
+
+	return readyResp, nil
+}
+
+// WriteAccessChecks prints the result of each bucket access check, followed by a fix hint
+// for every failed check
+func WriteAccessChecks(w io.Writer, cloud, bucketName, role string, resp *clusterproto.VerifyBucketAccessResponse) {
+	if len(resp.Checks) == 0 {
+		// Servers that predate individual checks only report the overall result
+		if resp.HasAccess {
+			fmt.Fprintf(w, "%s✓%s Bucket access verified\n", utils.GreenColor, utils.ResetColor)
+		} else {
+			fmt.Fprintf(w, "%s✗%s Bucket access verification failed: %s\n", utils.RedColor, utils.ResetColor, resp.Error)
+		}
+		return
+	}
+
+	writeChecks(w, cloud, bucketName, role, resp.Checks)
+}
+
+// WriteReadinessChecks prints the result of each resource readiness check, followed by a
+// fix hint for every failed check
+func WriteReadinessChecks(w io.Writer, cloud, bucketName, role string, resp *clusterproto.CheckResourceReadinessResponse) {
+	if len(resp.Checks) == 0 {
+		if resp.Ready {
+			fmt.Fprintf(w, "%s✓%s Resources ready\n", utils.GreenColor, utils.ResetColor)
+		} else {
+			fmt.Fprintf(w, "%s✗%s Resources not ready: %s\n", utils.RedColor, utils.ResetColor, resp.Error)
+		}
+		return
+	}
+
+	writeChecks(w, cloud, bucketName, role, resp.Checks)
+}
+
+// writeChecks prints a table of checks and the fix hints of the failed ones
+func writeChecks(w io.Writer, cloud, bucketName, role string, checks []*clusterproto.AccessCheck) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "  CHECK\tSTATUS\tCODE\tMESSAGE")
+	var failed []*clusterproto.AccessCheck
+	for _, check := range checks {
+		// Colors would throw off the column widths, so statuses are told apart by symbol
+		symbol := "-"
+		switch check.Status {
+		case CheckPassed:
+			symbol = "✓"
+		case CheckFailed:
+			symbol = "✗"
+			failed = append(failed, check)
+		}
+		fmt.Fprintf(tw, "%s %s\t%s\t%s\t%s\n", symbol, CheckLabel(check.Name), check.Status, check.ErrorCode, check.Message)
+	}
+	tw.Flush()
+
+	if len(failed) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "\n%sTo fix:%s\n", utils.BoldColor, utils.ResetColor)
+	if prov, err := provider.Get(cloud); err == nil {
+		for _, check := range failed {
+			if hint := prov.FixHint(bucketName, role, check.Name); hint != "" {
+				fmt.Fprintf(w, "  %s: %s\n", CheckLabel(check.Name), hint)
+			}
+		}
+	}
+	command := fmt.Sprintf("nsai bucket access-template --cloud %s --bucket %s", cloud, bucketName)
+	if role != "" {
+		command += " --role " + role
+	}
+	fmt.Fprintf(w, "\nRun '%s' to generate the complete setup.\n", command)
+}
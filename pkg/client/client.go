@@ -10,7 +10,10 @@ import (
 	"time"
 
 	authproto "github.com/nstreama-ai/nstream-ai-cli/proto/auth"
+	billingproto "github.com/nstreama-ai/nstream-ai-cli/proto/billing"
 	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+	logsproto "github.com/nstreama-ai/nstream-ai-cli/proto/logs"
+	metricsproto "github.com/nstreama-ai/nstream-ai-cli/proto/metrics"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -25,6 +28,9 @@ type Client struct {
 	AuthClient    authproto.AuthServiceClient
 	ClusterClient clusterproto.ClusterServiceClient
 	BucketClient  clusterproto.BucketServiceClient
+	BillingClient billingproto.BillingServiceClient
+	LogsClient    logsproto.LogServiceClient
+	MetricsClient metricsproto.MetricsServiceClient
 	// InitClient            authproto.InitServiceClient
 	// BaseModelClient       authproto.BaseModelServiceClient
 	// MegaModelClient       authproto.MegaModelServiceClient
@@ -95,6 +101,9 @@ func NewClient(serverAddr string, useTLS bool, caCertPath string) (*Client, erro
 		AuthClient:    authproto.NewAuthServiceClient(conn),
 		ClusterClient: clusterproto.NewClusterServiceClient(conn),
 		BucketClient:  clusterproto.NewBucketServiceClient(conn),
+		BillingClient: billingproto.NewBillingServiceClient(conn),
+		LogsClient:    logsproto.NewLogServiceClient(conn),
+		MetricsClient: metricsproto.NewMetricsServiceClient(conn),
 		// BaseModelClient:       proto.NewBaseModelServiceClient(conn),
 		// MegaModelClient:       proto.NewMegaModelServiceClient(conn),
 		// EmbeddingModelClient:  proto.NewEmbeddingModelServiceClient(conn),
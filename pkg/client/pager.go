@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+// DefaultPageSize is the number of items requested per page when no chunk size is given
+const DefaultPageSize = 100
+
+// ListOptions control how a list RPC is paged, filtered and ordered
+type ListOptions struct {
+	// Selector filters on labels; only supported by clusters and buckets
+	Selector *clusterproto.LabelSelector
+	// Filter is a server-side filter expression, e.g. "phase=ready"
+	Filter string
+	// OrderBy is a comma-separated list of fields, each optionally followed by " desc"
+	OrderBy string
+	// PageSize is the number of items fetched per request; DefaultPageSize when 0
+	PageSize int32
+	// Limit stops the listing after this many items; 0 lists everything
+	Limit int
+}
+
+// Validate checks the --limit and --chunk-size values
+func (o ListOptions) Validate() error {
+	if o.Limit < 0 {
+		return fmt.Errorf("--limit must not be negative")
+	}
+	if o.PageSize < 0 {
+		return fmt.Errorf("--chunk-size must not be negative")
+	}
+	return nil
+}
+
+// PageFunc fetches the page starting at pageToken. It returns the items and the token of the
+// next page, which is empty on the last page.
+type PageFunc[T any] func(ctx context.Context, pageToken string, pageSize int32) ([]T, string, error)
+
+// Iterator walks a paginated list RPC one page at a time, so results can be shown as they arrive
+type Iterator[T any] struct {
+	fetch    PageFunc[T]
+	pageSize int32
+	limit    int
+
+	token   string
+	fetched int
+	done    bool
+}
+
+// NewIterator creates an iterator over the pages returned by fetch
+func NewIterator[T any](fetch PageFunc[T], opts ListOptions) *Iterator[T] {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	return &Iterator[T]{
+		fetch:    fetch,
+		pageSize: pageSize,
+		limit:    opts.Limit,
+	}
+}
+
+// NextPage returns the next page of items, or io.EOF once every page has been read or the
+// limit is reached
+func (it *Iterator[T]) NextPage(ctx context.Context) ([]T, error) {
+	if it.done {
+		return nil, io.EOF
+	}
+
+	pageSize := it.pageSize
+	if it.limit > 0 && it.limit-it.fetched < int(pageSize) {
+		pageSize = int32(it.limit - it.fetched)
+	}
+
+	items, next, err := it.fetch(ctx, it.token, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// Servers may return more than asked for; never go past the limit
+	if it.limit > 0 && it.fetched+len(items) > it.limit {
+		items = items[:it.limit-it.fetched]
+	}
+
+	it.fetched += len(items)
+	it.token = next
+	if next == "" || (it.limit > 0 && it.fetched >= it.limit) {
+		it.done = true
+	}
+
+	return items, nil
+}
+
+// All reads the remaining pages and returns their items
+func (it *Iterator[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for {
+		items, err := it.NextPage(ctx)
+		if err == io.EOF {
+			return all, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+	}
+}
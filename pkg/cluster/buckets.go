@@ -0,0 +1,101 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+// Bucket purposes. A cluster has at most one bucket attached for each purpose.
+const (
+	PurposeData        = "data"
+	PurposeCheckpoints = "checkpoints"
+	PurposeLogs        = "logs"
+	PurposeKB          = "kb"
+)
+
+// BucketPurposes lists the bucket purposes in the order they are documented
+var BucketPurposes = []string{PurposeData, PurposeCheckpoints, PurposeLogs, PurposeKB}
+
+// ValidatePurpose checks that purpose is a known bucket purpose
+func ValidatePurpose(purpose string) error {
+	for _, p := range BucketPurposes {
+		if p == purpose {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid bucket purpose '%s' (must be one of %s)", purpose, strings.Join(BucketPurposes, ", "))
+}
+
+// AttachBucket attaches a bucket to a cluster for a purpose. An empty role uses the cluster's
+// role. With replace set, the bucket currently attached for the purpose is detached first.
+func (o *Operations) AttachBucket(ctx context.Context, clusterName, bucketName, purpose, role string, replace bool) (*clusterproto.BucketAttachment, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	attachResp, err := o.client.ClusterClient.AttachBucket(ctx, &clusterproto.AttachBucketRequest{
+		ClusterName: clusterName,
+		Bucket:      bucketName,
+		Purpose:     purpose,
+		Role:        role,
+		Replace:     replace,
+		AuthToken:   o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach bucket: %v", err)
+	}
+
+	if attachResp.Error != "" {
+		return nil, fmt.Errorf("failed to attach bucket: %s", attachResp.Error)
+	}
+
+	return attachResp.Attachment, nil
+}
+
+// DetachBucket detaches the attachments matching bucketName and purpose from a cluster. An
+// empty bucketName or purpose matches any value. It returns the removed attachments.
+func (o *Operations) DetachBucket(ctx context.Context, clusterName, bucketName, purpose string) ([]*clusterproto.BucketAttachment, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	detachResp, err := o.client.ClusterClient.DetachBucket(ctx, &clusterproto.DetachBucketRequest{
+		ClusterName: clusterName,
+		Bucket:      bucketName,
+		Purpose:     purpose,
+		AuthToken:   o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to detach bucket: %v", err)
+	}
+
+	if detachResp.Error != "" {
+		return nil, fmt.Errorf("failed to detach bucket: %s", detachResp.Error)
+	}
+
+	return detachResp.Detached, nil
+}
+
+// ListAttachedBuckets lists the buckets attached to a cluster
+func (o *Operations) ListAttachedBuckets(ctx context.Context, clusterName string) ([]*clusterproto.BucketAttachment, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	listResp, err := o.client.ClusterClient.ListClusterBuckets(ctx, &clusterproto.ListClusterBucketsRequest{
+		ClusterName: clusterName,
+		AuthToken:   o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster buckets: %v", err)
+	}
+
+	if listResp.Error != "" {
+		return nil, fmt.Errorf("failed to list cluster buckets: %s", listResp.Error)
+	}
+
+	return listResp.Attachments, nil
+}
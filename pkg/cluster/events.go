@@ -0,0 +1,119 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Event severities
+const (
+	SeverityInfo    = "info"
+	SeverityWarning = "warning"
+	SeverityError   = "error"
+)
+
+// ListEvents retrieves the recorded events of a cluster, or of all clusters when clusterName
+// is empty. kind and since are optional filters.
+func (o *Operations) ListEvents(ctx context.Context, clusterName, kind string, since time.Time) ([]*clusterproto.Event, error) {
+	return o.IterateEvents(clusterName, kind, since, client.ListOptions{}).All(ctx)
+}
+
+// IterateEvents returns an iterator that fetches events one page at a time
+func (o *Operations) IterateEvents(clusterName, kind string, since time.Time, opts client.ListOptions) *client.Iterator[*clusterproto.Event] {
+	return client.NewIterator(func(ctx context.Context, pageToken string, pageSize int32) ([]*clusterproto.Event, string, error) {
+		if o.config.User.AuthToken == "" {
+			return nil, "", fmt.Errorf("authentication token is missing. Please sign in first")
+		}
+
+		req := &clusterproto.ListEventsRequest{
+			ClusterName: clusterName,
+			Kind:        kind,
+			PageSize:    pageSize,
+			PageToken:   pageToken,
+			Filter:      opts.Filter,
+			OrderBy:     opts.OrderBy,
+			AuthToken:   o.config.User.AuthToken,
+		}
+		if !since.IsZero() {
+			req.Since = timestamppb.New(since)
+		}
+
+		eventsResp, err := o.client.ClusterClient.ListEvents(ctx, req)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to list events: %v", err)
+		}
+
+		if eventsResp.Error != "" {
+			return nil, "", fmt.Errorf("failed to list events: %s", eventsResp.Error)
+		}
+
+		return eventsResp.Events, eventsResp.NextPageToken, nil
+	}, opts)
+}
+
+// LatestEventSequence returns the sequence of the newest event matching the kind and filter,
+// or 0 if there is none
+func (o *Operations) LatestEventSequence(ctx context.Context, clusterName, kind, filter string) (int64, error) {
+	events, err := o.IterateEvents(clusterName, kind, time.Time{}, client.ListOptions{
+		Filter:   filter,
+		OrderBy:  "sequence desc",
+		PageSize: 1,
+		Limit:    1,
+	}).All(ctx)
+	if err != nil || len(events) == 0 {
+		return 0, err
+	}
+	return events[0].Sequence, nil
+}
+
+// WatchEvents streams events matching the kind and filter that are recorded after
+// afterSequence, calling onEvent for each one, until ctx is cancelled. A dropped stream is
+// reopened from the last received event.
+func (o *Operations) WatchEvents(ctx context.Context, clusterName, kind, filter string, afterSequence int64, onEvent func(*clusterproto.Event)) error {
+	if o.config.User.AuthToken == "" {
+		return fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	attempt := 0
+
+	for {
+		stream, err := o.client.ClusterClient.WatchEvents(ctx, &clusterproto.WatchEventsRequest{
+			ClusterName:   clusterName,
+			Kind:          kind,
+			AfterSequence: afterSequence,
+			AuthToken:     o.config.User.AuthToken,
+			Filter:        filter,
+		})
+
+		for err == nil {
+			var ev *clusterproto.Event
+			ev, err = stream.Recv()
+			if err != nil {
+				break
+			}
+
+			attempt = 0
+			afterSequence = ev.Sequence
+			if onEvent != nil {
+				onEvent(ev)
+			}
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+		if !client.IsRetryable(err) || attempt >= client.MaxReconnectAttempts {
+			return fmt.Errorf("failed to watch events: %v", err)
+		}
+
+		if err := client.Sleep(ctx, client.Backoff(attempt)); err != nil {
+			return nil
+		}
+		attempt++
+	}
+}
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"os"
 	"text/tabwriter"
+	"time"
 
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
 	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 // Operations handles cluster-related operations
@@ -18,59 +20,274 @@ type Operations struct {
 	config *config.Config
 }
 
-// NewOperations creates a new Operations instance
-func NewOperations() (*Operations, error) {
-	c, err := client.NewClient("", true, "")
+// NewOperationsWithClient creates an Operations instance that reuses an existing client and config
+func NewOperationsWithClient(c *client.Client, cfg *config.Config) *Operations {
+	return &Operations{
+		client: c,
+		config: cfg,
+	}
+}
+
+// ListClusters lists all available clusters. A non-nil selector is sent to the server so only
+// clusters with matching labels are returned.
+func (o *Operations) ListClusters(ctx context.Context, selector *clusterproto.LabelSelector) ([]*clusterproto.Cluster, error) {
+	return o.IterateClusters(client.ListOptions{Selector: selector}).All(ctx)
+}
+
+// IterateClusters returns an iterator that fetches clusters one page at a time
+func (o *Operations) IterateClusters(opts client.ListOptions) *client.Iterator[*clusterproto.Cluster] {
+	return client.NewIterator(func(ctx context.Context, pageToken string, pageSize int32) ([]*clusterproto.Cluster, string, error) {
+		if o.config.User.AuthToken == "" {
+			return nil, "", fmt.Errorf("authentication token is missing. Please sign in first")
+		}
+
+		listResp, err := o.client.ClusterClient.ListClusters(ctx, &clusterproto.ListClustersRequest{
+			Selector:  opts.Selector,
+			PageSize:  pageSize,
+			PageToken: pageToken,
+			Filter:    opts.Filter,
+			OrderBy:   opts.OrderBy,
+			AuthToken: o.config.User.AuthToken,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to list clusters: %v", err)
+		}
+
+		return listResp.Clusters, listResp.NextPageToken, nil
+	}, opts)
+}
+
+// GetClusterDetails gets details for a specific cluster
+func (o *Operations) GetClusterDetails(ctx context.Context, clusterName string) (*clusterproto.ClusterConfig, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	detailsResp, err := o.client.ClusterClient.GetClusterDetails(ctx, &clusterproto.GetClusterDetailsRequest{
+		ClusterName: clusterName,
+		AuthToken:   o.config.User.AuthToken,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create client: %v", err)
+		return nil, fmt.Errorf("failed to get cluster details: %v", err)
+	}
+
+	if detailsResp.Error != "" {
+		return nil, fmt.Errorf("failed to get cluster details: %s", detailsResp.Error)
 	}
 
-	cfg, err := config.LoadConfig()
+	return detailsResp.Config, nil
+}
+
+// CloneCluster starts provisioning a copy of an existing cluster. Empty override fields in req
+// keep the source cluster's settings.
+func (o *Operations) CloneCluster(ctx context.Context, req *clusterproto.CloneClusterRequest) (*clusterproto.CloneClusterResponse, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	req.AuthToken = o.config.User.AuthToken
+	cloneResp, err := o.client.ClusterClient.CloneCluster(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load config: %v", err)
+		return nil, fmt.Errorf("failed to clone cluster: %v", err)
 	}
 
-	return &Operations{
-		client: c,
-		config: cfg,
-	}, nil
+	if cloneResp.Error != "" {
+		return nil, fmt.Errorf("failed to clone cluster: %s", cloneResp.Error)
+	}
+
+	return cloneResp, nil
 }
 
-// ListClusters lists all available clusters
-func (o *Operations) ListClusters(ctx context.Context) ([]*clusterproto.Cluster, error) {
+// DeleteCluster starts deleting a cluster and returns the deletion operation
+func (o *Operations) DeleteCluster(ctx context.Context, clusterName string) (*clusterproto.ClusterOperation, error) {
 	if o.config.User.AuthToken == "" {
 		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
 	}
 
-	listResp, err := o.client.ClusterClient.ListClusters(ctx, &clusterproto.ListClustersRequest{
-		AuthToken: o.config.User.AuthToken,
+	deleteResp, err := o.client.ClusterClient.DeleteCluster(ctx, &clusterproto.DeleteClusterRequest{
+		ClusterName: clusterName,
+		AuthToken:   o.config.User.AuthToken,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to list clusters: %v", err)
+		return nil, fmt.Errorf("failed to delete cluster: %v", err)
 	}
 
-	return listResp.Clusters, nil
+	if deleteResp.Error != "" {
+		return nil, fmt.Errorf("failed to delete cluster: %s", deleteResp.Error)
+	}
+
+	return deleteResp.Operation, nil
 }
 
-// GetClusterDetails gets details for a specific cluster
-func (o *Operations) GetClusterDetails(ctx context.Context, clusterName string) (*clusterproto.ClusterConfig, error) {
+// UpdateCluster updates the fields of a cluster named in updateMask. With dryRun set the
+// server validates the update and returns the resulting config without applying it.
+func (o *Operations) UpdateCluster(ctx context.Context, clusterName string, cfg *clusterproto.ClusterConfig, updateMask []string, dryRun bool) (*clusterproto.ClusterConfig, error) {
 	if o.config.User.AuthToken == "" {
 		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
 	}
 
-	detailsResp, err := o.client.ClusterClient.GetClusterDetails(ctx, &clusterproto.GetClusterDetailsRequest{
+	updateResp, err := o.client.ClusterClient.UpdateCluster(ctx, &clusterproto.UpdateClusterRequest{
 		ClusterName: clusterName,
+		Config:      cfg,
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: updateMask},
+		DryRun:      dryRun,
 		AuthToken:   o.config.User.AuthToken,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get cluster details: %v", err)
+		return nil, fmt.Errorf("failed to update cluster: %v", err)
 	}
 
-	if detailsResp.Error != "" {
-		return nil, fmt.Errorf("failed to get cluster details: %s", detailsResp.Error)
+	if updateResp.Error != "" {
+		return nil, fmt.Errorf("failed to update cluster: %s", updateResp.Error)
 	}
 
-	return detailsResp.Config, nil
+	return updateResp.Config, nil
+}
+
+// ChangeTier starts migrating a cluster to a different tier and returns the migration operation
+func (o *Operations) ChangeTier(ctx context.Context, clusterName, tier string) (*clusterproto.ClusterOperation, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	tierResp, err := o.client.ClusterClient.ChangeClusterTier(ctx, &clusterproto.ChangeClusterTierRequest{
+		ClusterName: clusterName,
+		Type:        tier,
+		AuthToken:   o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to change cluster type: %v", err)
+	}
+
+	if tierResp.Error != "" {
+		return nil, fmt.Errorf("failed to change cluster type: %s", tierResp.Error)
+	}
+
+	return tierResp.Operation, nil
+}
+
+// PauseCluster starts pausing a cluster and returns the long-running operation
+func (o *Operations) PauseCluster(ctx context.Context, clusterName string) (*clusterproto.ClusterOperation, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	pauseResp, err := o.client.ClusterClient.PauseCluster(ctx, &clusterproto.PauseClusterRequest{
+		ClusterName: clusterName,
+		AuthToken:   o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to pause cluster: %v", err)
+	}
+
+	if pauseResp.Error != "" {
+		return nil, fmt.Errorf("failed to pause cluster: %s", pauseResp.Error)
+	}
+
+	return pauseResp.Operation, nil
+}
+
+// ResumeCluster starts resuming a paused cluster and returns the long-running operation
+func (o *Operations) ResumeCluster(ctx context.Context, clusterName string) (*clusterproto.ClusterOperation, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	resumeResp, err := o.client.ClusterClient.ResumeCluster(ctx, &clusterproto.ResumeClusterRequest{
+		ClusterName: clusterName,
+		AuthToken:   o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume cluster: %v", err)
+	}
+
+	if resumeResp.Error != "" {
+		return nil, fmt.Errorf("failed to resume cluster: %s", resumeResp.Error)
+	}
+
+	return resumeResp.Operation, nil
+}
+
+// ScaleCluster starts scaling a cluster; a zero replicas or empty size leaves that dimension unchanged
+func (o *Operations) ScaleCluster(ctx context.Context, clusterName string, replicas int32, size string) (*clusterproto.ClusterOperation, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	scaleResp, err := o.client.ClusterClient.ScaleCluster(ctx, &clusterproto.ScaleClusterRequest{
+		ClusterName: clusterName,
+		Replicas:    replicas,
+		Size:        size,
+		AuthToken:   o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scale cluster: %v", err)
+	}
+
+	if scaleResp.Error != "" {
+		return nil, fmt.Errorf("failed to scale cluster: %s", scaleResp.Error)
+	}
+
+	return scaleResp.Operation, nil
+}
+
+// GetOperation gets the current state of a cluster operation
+func (o *Operations) GetOperation(ctx context.Context, operationID string) (*clusterproto.ClusterOperation, error) {
+	opResp, err := o.client.ClusterClient.GetClusterOperation(ctx, &clusterproto.GetClusterOperationRequest{
+		OperationId: operationID,
+		AuthToken:   o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operation status: %v", err)
+	}
+
+	if opResp.Error != "" {
+		return nil, fmt.Errorf("failed to get operation status: %s", opResp.Error)
+	}
+
+	return opResp.Operation, nil
+}
+
+// WaitForOperation polls a cluster operation until it is done, calling onUpdate
+// with every observed state. It returns an error if the operation failed.
+func (o *Operations) WaitForOperation(ctx context.Context, operationID string, interval time.Duration, onUpdate func(*clusterproto.ClusterOperation)) (*clusterproto.ClusterOperation, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		op, err := o.GetOperation(ctx, operationID)
+		if err != nil {
+			return nil, err
+		}
+
+		if onUpdate != nil {
+			onUpdate(op)
+		}
+
+		if op.Done {
+			if op.Error != "" {
+				return op, fmt.Errorf("%s operation failed: %s", op.Type, op.Error)
+			}
+			return op, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return op, fmt.Errorf("timed out waiting for %s operation: %v", op.Type, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// RemoveFromConfig clears the current cluster context if it references the given cluster.
+// It reports whether the config was changed.
+func (o *Operations) RemoveFromConfig(clusterName string) (bool, error) {
+	if o.config.Cluster.Name != clusterName {
+		return false, nil
+	}
+
+	o.config.Cluster = config.ClusterConfig{}
+	return true, config.SaveConfig(o.config)
 }
 
 // DisplayClusters displays clusters in a table format
@@ -102,10 +319,3 @@ func (o *Operations) UpdateConfig(clusterName string, details *clusterproto.Clus
 	}
 	return config.SaveConfig(o.config)
 }
-
-// Close closes the client connection
-func (o *Operations) Close() {
-	if o.client != nil {
-		o.client.Close()
-	}
-}
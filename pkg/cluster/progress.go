@@ -0,0 +1,83 @@
+package cluster
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+// phaseLabels are the human-readable names of cluster phases
+var phaseLabels = map[string]string{
+	PhaseProvisioning:            "Provisioning cluster",
+	PhaseAttachingBucket:         "Attaching bucket",
+	PhaseLoadingBaseModels:       "Loading base models",
+	PhasePreparingKnowledgebases: "Preparing knowledgebases",
+	PhaseReady:                   "Cluster ready",
+	PhaseFailed:                  "Cluster failed",
+	PhasePausing:                 "Pausing cluster",
+	PhasePaused:                  "Cluster paused",
+	PhaseResuming:                "Resuming cluster",
+}
+
+// PhaseLabel returns the human-readable name of a cluster phase
+func PhaseLabel(phase string) string {
+	if label, ok := phaseLabels[phase]; ok {
+		return label
+	}
+	return phase
+}
+
+// ProgressDisplay renders live cluster status updates, completing one line per phase
+type ProgressDisplay struct {
+	out   io.Writer
+	phase string
+}
+
+// NewProgressDisplay creates a ProgressDisplay writing to out
+func NewProgressDisplay(out io.Writer) *ProgressDisplay {
+	return &ProgressDisplay{out: out}
+}
+
+// Update renders a status update. It can be passed directly to WatchCluster.
+func (p *ProgressDisplay) Update(st *clusterproto.ClusterStatus) {
+	if p.phase != "" && st.Phase != p.phase {
+		fmt.Fprintf(p.out, "\r\033[K%s✓%s %s\n", utils.GreenColor, utils.ResetColor, PhaseLabel(p.phase))
+	}
+	p.phase = st.Phase
+
+	if st.Done {
+		if st.Error != "" {
+			fmt.Fprintf(p.out, "\r\033[K%s✗%s %s: %s\n", utils.RedColor, utils.ResetColor, PhaseLabel(st.Phase), st.Error)
+		} else {
+			fmt.Fprintf(p.out, "\r\033[K%s✓%s %s\n", utils.GreenColor, utils.ResetColor, PhaseLabel(st.Phase))
+		}
+		p.phase = ""
+		return
+	}
+
+	line := fmt.Sprintf("%s•%s %s", utils.BoldColor, utils.ResetColor, PhaseLabel(st.Phase))
+	if st.ProgressPercent > 0 {
+		line += fmt.Sprintf(" (%d%%)", st.ProgressPercent)
+	}
+	if st.Message != "" {
+		line += " " + st.Message
+	}
+	fmt.Fprintf(p.out, "\r\033[K%s", line)
+}
+
+// UpdateOperation renders the latest state of a long-running cluster operation on a
+// single line. It can be passed directly to WaitForOperation.
+func (p *ProgressDisplay) UpdateOperation(op *clusterproto.ClusterOperation) {
+	p.phase = op.Phase
+	fmt.Fprintf(p.out, "\r\033[K%s%-12s%s %3d%%  %s", utils.BoldColor, op.Phase, utils.ResetColor, op.ProgressPercent, op.Message)
+}
+
+// Finish ends an in-progress line, e.g. after the watch was interrupted
+func (p *ProgressDisplay) Finish() {
+	if p.phase != "" {
+		fmt.Fprintln(p.out)
+		p.phase = ""
+	}
+}
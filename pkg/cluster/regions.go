@@ -0,0 +1,191 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// RegionCacheTTL is how long the region catalog is used before it is fetched again
+const RegionCacheTTL = 24 * time.Hour
+
+// RegionCatalog is the set of regions clusters and buckets can be created in
+type RegionCatalog struct {
+	Regions   []*clusterproto.Region
+	FetchedAt time.Time
+	// Stale is set when the mothership could not be reached and an expired cache was used
+	Stale bool
+}
+
+// regionCache is the region catalog as stored on disk. Prices can differ per organization,
+// so the catalog is only reused for the user that fetched it.
+type regionCache struct {
+	Email     string            `json:"email"`
+	FetchedAt time.Time         `json:"fetched_at"`
+	Regions   []json.RawMessage `json:"regions"`
+}
+
+func regionCachePath() string {
+	return filepath.Join(config.GetCacheDir(), "regions.json")
+}
+
+// ListRegions lists the regions of a cloud provider from the mothership. An empty provider
+// lists the regions of every provider.
+func (o *Operations) ListRegions(ctx context.Context, provider string) ([]*clusterproto.Region, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	listResp, err := o.client.ClusterClient.ListRegions(ctx, &clusterproto.ListRegionsRequest{
+		CloudProvider: provider,
+		AuthToken:     o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list regions: %v", err)
+	}
+
+	if listResp.Error != "" {
+		return nil, fmt.Errorf("failed to list regions: %s", listResp.Error)
+	}
+
+	return listResp.Regions, nil
+}
+
+// RegionCatalog returns the regions of every provider from the local cache, fetching them
+// again once the cache is older than RegionCacheTTL. If the mothership cannot be reached,
+// an expired cache is used instead so regions can still be chosen offline.
+func (o *Operations) RegionCatalog(ctx context.Context) (*RegionCatalog, error) {
+	cached := o.loadRegionCache()
+	if cached != nil && time.Since(cached.FetchedAt) < RegionCacheTTL {
+		return cached, nil
+	}
+
+	regions, err := o.ListRegions(ctx, "")
+	if err != nil {
+		if cached != nil {
+			cached.Stale = true
+			return cached, nil
+		}
+		return nil, err
+	}
+
+	catalog := &RegionCatalog{Regions: regions, FetchedAt: time.Now()}
+	o.saveRegionCache(catalog)
+	return catalog, nil
+}
+
+func (o *Operations) loadRegionCache() *RegionCatalog {
+	data, err := os.ReadFile(regionCachePath())
+	if err != nil {
+		return nil
+	}
+
+	var cache regionCache
+	if err := json.Unmarshal(data, &cache); err != nil || cache.Email != o.config.User.Email {
+		return nil
+	}
+
+	catalog := &RegionCatalog{FetchedAt: cache.FetchedAt}
+	for _, raw := range cache.Regions {
+		region := &clusterproto.Region{}
+		if err := protojson.Unmarshal(raw, region); err != nil {
+			return nil
+		}
+		catalog.Regions = append(catalog.Regions, region)
+	}
+	return catalog
+}
+
+func (o *Operations) saveRegionCache(catalog *RegionCatalog) {
+	cache := regionCache{Email: o.config.User.Email, FetchedAt: catalog.FetchedAt}
+	for _, region := range catalog.Regions {
+		raw, err := protojson.Marshal(region)
+		if err != nil {
+			return
+		}
+		cache.Regions = append(cache.Regions, raw)
+	}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+
+	// The cache is an optimisation only, so failures to write it are ignored
+	if err := os.MkdirAll(config.GetCacheDir(), 0700); err != nil {
+		return
+	}
+	_ = os.WriteFile(regionCachePath(), data, 0600)
+}
+
+// Find returns a region of a provider by name, or nil if the catalog does not have it
+func (c *RegionCatalog) Find(provider, name string) *clusterproto.Region {
+	for _, region := range c.Regions {
+		if region.CloudProvider == provider && region.Name == name {
+			return region
+		}
+	}
+	return nil
+}
+
+// Available returns the regions of a provider that accept new clusters and buckets. With a
+// cluster type, only the regions where that tier is available are returned.
+func (c *RegionCatalog) Available(provider, clusterType string) []*clusterproto.Region {
+	var regions []*clusterproto.Region
+	for _, region := range c.Regions {
+		if region.CloudProvider != provider || !region.Available {
+			continue
+		}
+		if clusterType != "" {
+			if tier := RegionTier(region, clusterType); tier == nil || !tier.Available {
+				continue
+			}
+		}
+		regions = append(regions, region)
+	}
+	return regions
+}
+
+// Validate checks that a region of the provider accepts new clusters and buckets, and that
+// the cluster type is available in it when one is given
+func (c *RegionCatalog) Validate(provider, clusterType, name string) error {
+	var names []string
+	for _, region := range c.Available(provider, clusterType) {
+		names = append(names, region.Name)
+	}
+	available := strings.Join(names, ", ")
+	if available == "" {
+		available = "none"
+	}
+
+	region := c.Find(provider, name)
+	switch {
+	case region == nil:
+		return fmt.Errorf("unknown %s region '%s' (available: %s)", provider, name, available)
+	case !region.Available:
+		return fmt.Errorf("region '%s' is not accepting new clusters or buckets (available: %s)", name, available)
+	case clusterType != "":
+		if tier := RegionTier(region, clusterType); tier == nil || !tier.Available {
+			return fmt.Errorf("%s clusters are not available in region '%s' (available: %s)", clusterType, name, available)
+		}
+	}
+	return nil
+}
+
+// RegionTier returns the tier of a region for a cluster type, or nil if it is not offered
+func RegionTier(region *clusterproto.Region, clusterType string) *clusterproto.RegionTier {
+	for _, tier := range region.Tiers {
+		if tier.ClusterType == clusterType {
+			return tier
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+// ExportResources retrieves the specs of every resource defined on a cluster, secrets excluded
+func (o *Operations) ExportResources(ctx context.Context, clusterName string) ([]*clusterproto.ResourceSpec, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	exportResp, err := o.client.ClusterClient.ExportResources(ctx, &clusterproto.ExportResourcesRequest{
+		ClusterName: clusterName,
+		AuthToken:   o.config.User.AuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export resources: %v", err)
+	}
+
+	if exportResp.Error != "" {
+		return nil, fmt.Errorf("failed to export resources: %s", exportResp.Error)
+	}
+
+	return exportResp.Resources, nil
+}
+
+// ApplyResource creates a resource on a cluster, or replaces an existing one when overwrite is set.
+// It returns "created" or "updated".
+func (o *Operations) ApplyResource(ctx context.Context, clusterName string, resource *clusterproto.ResourceSpec, overwrite bool) (string, error) {
+	if o.config.User.AuthToken == "" {
+		return "", fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	applyResp, err := o.client.ClusterClient.ApplyResource(ctx, &clusterproto.ApplyResourceRequest{
+		ClusterName: clusterName,
+		Resource:    resource,
+		Overwrite:   overwrite,
+		AuthToken:   o.config.User.AuthToken,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to apply %s/%s: %v", resource.Kind, resource.Name, err)
+	}
+
+	if applyResp.Error != "" {
+		return "", fmt.Errorf("failed to apply %s/%s: %s", resource.Kind, resource.Name, applyResp.Error)
+	}
+
+	return applyResp.Result, nil
+}
@@ -0,0 +1,31 @@
+package cluster
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Cluster tiers, from smallest to largest
+const (
+	TierBasic      = "basic"
+	TierStandard   = "standard"
+	TierEnterprise = "enterprise"
+)
+
+// Tiers lists the cluster tiers in ascending order
+var Tiers = []string{TierBasic, TierStandard, TierEnterprise}
+
+// TierRank returns the position of a tier in Tiers, or an error for an unknown tier
+func TierRank(tier string) (int, error) {
+	for i, t := range Tiers {
+		if t == tier {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("unknown cluster type %q (must be one of %s)", tier, strings.Join(Tiers, ", "))
+}
+
+// IsPaidTier reports whether a tier requires credits
+func IsPaidTier(tier string) bool {
+	return tier != TierBasic
+}
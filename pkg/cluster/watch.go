@@ -0,0 +1,76 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+// Cluster phases reported by CreateCluster, ResumeCluster and WatchCluster
+const (
+	PhaseProvisioning            = "provisioning"
+	PhaseAttachingBucket         = "attaching_bucket"
+	PhaseLoadingBaseModels       = "loading_base_models"
+	PhasePreparingKnowledgebases = "preparing_knowledgebases"
+	PhaseReady                   = "ready"
+	PhaseFailed                  = "failed"
+	PhasePausing                 = "pausing"
+	PhasePaused                  = "paused"
+	PhaseResuming                = "resuming"
+)
+
+// WatchCluster streams status updates for a cluster until it reports done, calling
+// onStatus for each update. A dropped stream is reopened from the last received status.
+func (o *Operations) WatchCluster(ctx context.Context, clusterName string, onStatus func(*clusterproto.ClusterStatus)) (*clusterproto.ClusterStatus, error) {
+	if o.config.User.AuthToken == "" {
+		return nil, fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	var last *clusterproto.ClusterStatus
+	var afterSequence int64
+	attempt := 0
+
+	for {
+		stream, err := o.client.ClusterClient.WatchCluster(ctx, &clusterproto.WatchClusterRequest{
+			ClusterName:   clusterName,
+			AuthToken:     o.config.User.AuthToken,
+			AfterSequence: afterSequence,
+		})
+
+		for err == nil {
+			var st *clusterproto.ClusterStatus
+			st, err = stream.Recv()
+			if err != nil {
+				break
+			}
+
+			attempt = 0
+			last = st
+			afterSequence = st.Sequence
+			if onStatus != nil {
+				onStatus(st)
+			}
+
+			if st.Done {
+				if st.Error != "" {
+					return st, fmt.Errorf("cluster %s failed: %s", clusterName, st.Error)
+				}
+				return st, nil
+			}
+		}
+
+		if ctx.Err() != nil {
+			return last, fmt.Errorf("stopped watching cluster %s: %v", clusterName, ctx.Err())
+		}
+		if !client.IsRetryable(err) || attempt >= client.MaxReconnectAttempts {
+			return last, fmt.Errorf("failed to watch cluster %s: %v", clusterName, err)
+		}
+
+		if err := client.Sleep(ctx, client.Backoff(attempt)); err != nil {
+			return last, fmt.Errorf("stopped watching cluster %s: %v", clusterName, err)
+		}
+		attempt++
+	}
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
 	"github.com/spf13/cobra"
 )
 
@@ -15,5 +16,6 @@ func RegisterCommands(rootCmd *cobra.Command) {
 	authCmd.AddCommand(NewSigninCmd())
 	authCmd.AddCommand(NewSignupCmd())
 
-	rootCmd.AddCommand(authCmd)
+	// Signing in and signing up are how credentials are obtained in the first place
+	rootCmd.AddCommand(auth.SkipAuth(authCmd))
 }
@@ -0,0 +1,79 @@
+package billing
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	billingops "github.com/nstreama-ai/nstream-ai-cli/pkg/billing"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/printer"
+	billingproto "github.com/nstreama-ai/nstream-ai-cli/proto/billing"
+	"github.com/spf13/cobra"
+)
+
+// billingFormats are the output formats supported by billing commands
+var billingFormats = []string{printer.FormatTable, printer.FormatJSON, printer.FormatCSV}
+
+// NewBalanceCmd creates the billing balance command
+func NewBalanceCmd() *cobra.Command {
+	var outputFormat string
+
+	cmd := &cobra.Command{
+		Use:   "balance",
+		Short: "Show the available credit balance",
+		Long:  `Show your organization's available credits and the current spend rate across all clusters.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := printer.ValidateFormat(outputFormat, billingFormats...); err != nil {
+				return err
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			balance, err := billingops.NewOperationsWithClient(session.Client, session.Config).GetBalance(ctx)
+			if err != nil {
+				return err
+			}
+
+			return printer.PrintItem(os.Stdout, outputFormat, balance, balancePrintOptions())
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", printer.FormatTable, printer.FormatHelp(billingFormats...))
+
+	return cmd
+}
+
+// balancePrintOptions returns the printer options for a credit balance
+func balancePrintOptions() printer.Options[*billingproto.GetBalanceResponse] {
+	return printer.Options[*billingproto.GetBalanceResponse]{
+		Kind: "balance",
+		Name: func(b *billingproto.GetBalanceResponse) string { return "" },
+		Columns: []printer.Column[*billingproto.GetBalanceResponse]{
+			{Header: "AVAILABLE CREDITS", Value: func(b *billingproto.GetBalanceResponse) string { return fmt.Sprint(b.AvailableCredits) }},
+			{Header: "CREDITS/HOUR", Value: func(b *billingproto.GetBalanceResponse) string { return fmt.Sprint(b.CreditsPerHour) }},
+			{Header: "HOURS LEFT", Value: func(b *billingproto.GetBalanceResponse) string { return hoursLeft(b) }},
+			{Header: "AS OF", Value: func(b *billingproto.GetBalanceResponse) string {
+				if b.AsOf == nil {
+					return ""
+				}
+				return b.AsOf.AsTime().Local().Format(time.RFC3339)
+			}},
+		},
+	}
+}
+
+// hoursLeft estimates how long the balance lasts at the current spend rate
+func hoursLeft(b *billingproto.GetBalanceResponse) string {
+	if b.CreditsPerHour <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f", float64(b.AvailableCredits)/float64(b.CreditsPerHour))
+}
@@ -0,0 +1,23 @@
+package billing
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewBillingCmd creates the root billing command
+func NewBillingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "billing",
+		Short: "View credit balance, usage and invoices",
+		Long:  `View your organization's credit balance, credit usage and invoices`,
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		NewBalanceCmd(),
+		NewUsageCmd(),
+		NewInvoicesCmd(),
+	)
+
+	return cmd
+}
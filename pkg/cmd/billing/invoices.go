@@ -0,0 +1,194 @@
+package billing
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	billingops "github.com/nstreama-ai/nstream-ai-cli/pkg/billing"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/printer"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	billingproto "github.com/nstreama-ai/nstream-ai-cli/proto/billing"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewInvoicesCmd creates the billing invoices command
+func NewInvoicesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invoices",
+		Short: "List and download invoices",
+		Long:  `List your organization's invoices and download them as PDF or CSV`,
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		newInvoicesListCmd(),
+		newInvoicesDownloadCmd(),
+	)
+
+	return cmd
+}
+
+func newInvoicesListCmd() *cobra.Command {
+	var (
+		outputFormat string
+		listOpts     client.ListOptions
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List invoices",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := printer.ValidateFormat(outputFormat, billingFormats...); err != nil {
+				return err
+			}
+			if err := listOpts.Validate(); err != nil {
+				return err
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			it := billingops.NewOperationsWithClient(session.Client, session.Config).IterateInvoices(listOpts)
+			n, err := printer.PrintPages(ctx, os.Stdout, outputFormat, it, invoicePrintOptions())
+			if err != nil {
+				return err
+			}
+
+			if n == 0 && outputFormat == printer.FormatTable {
+				fmt.Println("No invoices found.")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", printer.FormatTable, printer.FormatHelp(billingFormats...))
+	cmd.Flags().StringVar(&listOpts.Filter, "filter", "", "Server-side filter expression (e.g. \"status=unpaid\")")
+	cmd.Flags().StringVar(&listOpts.OrderBy, "sort-by", "", "Comma-separated fields to order by, each optionally followed by ' desc'")
+	cmd.Flags().IntVar(&listOpts.Limit, "limit", 0, "Maximum number of invoices to list (0 lists all)")
+	cmd.Flags().Int32Var(&listOpts.PageSize, "chunk-size", client.DefaultPageSize, "Number of invoices fetched per request")
+
+	return cmd
+}
+
+func newInvoicesDownloadCmd() *cobra.Command {
+	var (
+		format     string
+		outputFile string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "download <invoice-id>",
+		Short: "Download an invoice",
+		Long: `Download an invoice as PDF or CSV.
+
+The file is saved in the current directory under the name suggested by the
+server unless --output-file is given ('-' writes to stdout).`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != "pdf" && format != "csv" {
+				return fmt.Errorf("invalid --format value %q (must be pdf or csv)", format)
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			done := make(chan bool)
+			if outputFile != "-" {
+				go utils.ShowDefaultLoading(fmt.Sprintf("Downloading invoice '%s'", args[0]), done)
+			}
+
+			invoice, err := billingops.NewOperationsWithClient(session.Client, session.Config).DownloadInvoice(ctx, args[0], format)
+			if outputFile != "-" {
+				done <- true
+			}
+			if err != nil {
+				return err
+			}
+
+			if outputFile == "-" {
+				_, err := os.Stdout.Write(invoice.Content)
+				return err
+			}
+
+			path := outputFile
+			if path == "" {
+				path = invoiceFilename(invoice.Filename, args[0], format)
+			}
+
+			if err := os.WriteFile(path, invoice.Content, 0644); err != nil {
+				return fmt.Errorf("failed to save invoice: %v", err)
+			}
+
+			fmt.Printf("%s✓ Saved invoice '%s' to %s%s\n", utils.BoldColor, args[0], path, utils.ResetColor)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "pdf", "Invoice format (pdf, csv)")
+	cmd.Flags().StringVar(&outputFile, "output-file", "", "File to save the invoice to ('-' for stdout)")
+
+	return cmd
+}
+
+// invoicePrintOptions returns the printer options for invoices
+func invoicePrintOptions() printer.Options[*billingproto.Invoice] {
+	return printer.Options[*billingproto.Invoice]{
+		Kind: "invoice",
+		Name: func(i *billingproto.Invoice) string { return i.Id },
+		Columns: []printer.Column[*billingproto.Invoice]{
+			{Header: "ID", Value: func(i *billingproto.Invoice) string { return i.Id }},
+			{Header: "PERIOD START", Value: func(i *billingproto.Invoice) string { return formatDate(i.PeriodStart) }},
+			{Header: "PERIOD END", Value: func(i *billingproto.Invoice) string { return formatDate(i.PeriodEnd) }},
+			{Header: "CREDITS", Value: func(i *billingproto.Invoice) string { return fmt.Sprint(i.Credits) }},
+			{Header: "AMOUNT", Value: func(i *billingproto.Invoice) string {
+				return formatAmount(i.AmountCents, i.Currency)
+			}},
+			{Header: "STATUS", Value: func(i *billingproto.Invoice) string { return i.Status }},
+			{Header: "ISSUED", Value: func(i *billingproto.Invoice) string { return formatDate(i.IssuedAt) }},
+		},
+	}
+}
+
+// invoiceFilename returns the file an invoice is saved to when none is given. The server
+// suggests a name, but only its base name is used so it cannot write outside the current
+// directory.
+func invoiceFilename(suggested, id, format string) string {
+	name := filepath.Base(suggested)
+	if suggested == "" || name == "." || name == ".." || name == string(filepath.Separator) {
+		return fmt.Sprintf("invoice-%s.%s", id, format)
+	}
+	return name
+}
+
+// formatAmount renders an amount in cents, such as -150 for a refund, as "-1.50 USD"
+func formatAmount(cents int64, currency string) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d %s", sign, cents/100, cents%100, currency)
+}
+
+func formatDate(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Local().Format(time.DateOnly)
+}
@@ -0,0 +1,137 @@
+package billing
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	billingops "github.com/nstreama-ai/nstream-ai-cli/pkg/billing"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/printer"
+	billingproto "github.com/nstreama-ai/nstream-ai-cli/proto/billing"
+	"github.com/spf13/cobra"
+)
+
+// NewUsageCmd creates the billing usage command
+func NewUsageCmd() *cobra.Command {
+	var (
+		from         string
+		to           string
+		groupBy      string
+		outputFormat string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "Show credit usage for a period",
+		Long: `Show credits spent between --from and --to, grouped by cluster, resource kind or day.
+
+Dates are given as YYYY-MM-DD or RFC 3339 timestamps. A date-only --to includes
+the whole day. The period defaults to the current calendar month.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := printer.ValidateFormat(outputFormat, billingFormats...); err != nil {
+				return err
+			}
+			if !validGroupBy(groupBy) {
+				return fmt.Errorf("invalid --group-by value %q (must be one of %s)", groupBy, strings.Join(billingops.GroupByKeys, ", "))
+			}
+
+			now := time.Now()
+			start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+			end := now
+
+			var err error
+			if from != "" {
+				if start, err = parseTime(from, false); err != nil {
+					return fmt.Errorf("invalid --from value: %v", err)
+				}
+			}
+			if to != "" {
+				if end, err = parseTime(to, true); err != nil {
+					return fmt.Errorf("invalid --to value: %v", err)
+				}
+			}
+			if !end.After(start) {
+				return fmt.Errorf("--to must be after --from")
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			usage, err := billingops.NewOperationsWithClient(session.Client, session.Config).GetUsage(ctx, start, end, groupBy)
+			if err != nil {
+				return err
+			}
+
+			switch outputFormat {
+			case printer.FormatJSON:
+				return printer.PrintItem(os.Stdout, outputFormat, usage, printer.Options[*billingproto.GetUsageResponse]{})
+			case printer.FormatTable:
+				if len(usage.Records) == 0 {
+					fmt.Println("No usage found for this period.")
+					return nil
+				}
+				if err := printer.PrintList(os.Stdout, outputFormat, usage.Records, usagePrintOptions(groupBy)); err != nil {
+					return err
+				}
+				fmt.Printf("\nTotal: %d credits (%s to %s)\n", usage.TotalCredits, start.Format(time.RFC3339), end.Format(time.RFC3339))
+				return nil
+			default:
+				return printer.PrintList(os.Stdout, outputFormat, usage.Records, usagePrintOptions(groupBy))
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "", "Start of the period (YYYY-MM-DD or RFC 3339, default: start of the current month)")
+	cmd.Flags().StringVar(&to, "to", "", "End of the period (YYYY-MM-DD or RFC 3339, default: now)")
+	cmd.Flags().StringVar(&groupBy, "group-by", billingops.GroupByCluster, "Group usage by "+strings.Join(billingops.GroupByKeys, ", "))
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", printer.FormatTable, printer.FormatHelp(billingFormats...))
+
+	return cmd
+}
+
+// usagePrintOptions returns the printer options for usage records grouped by groupBy
+func usagePrintOptions(groupBy string) printer.Options[*billingproto.UsageRecord] {
+	return printer.Options[*billingproto.UsageRecord]{
+		Kind: "usage",
+		Name: func(r *billingproto.UsageRecord) string { return r.Key },
+		Columns: []printer.Column[*billingproto.UsageRecord]{
+			{Header: strings.ToUpper(groupBy), Value: func(r *billingproto.UsageRecord) string { return r.Key }},
+			{Header: "HOURS", Value: func(r *billingproto.UsageRecord) string { return fmt.Sprintf("%.2f", r.Hours) }},
+			{Header: "CREDITS", Value: func(r *billingproto.UsageRecord) string { return fmt.Sprint(r.Credits) }},
+		},
+	}
+}
+
+func validGroupBy(groupBy string) bool {
+	for _, k := range billingops.GroupByKeys {
+		if groupBy == k {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTime parses a YYYY-MM-DD date or an RFC 3339 timestamp. With endOfDay set,
+// a date-only value refers to the end of that day so the whole day is included.
+func parseTime(value string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a YYYY-MM-DD date or RFC 3339 timestamp", value)
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
+}
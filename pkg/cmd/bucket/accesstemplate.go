@@ -0,0 +1,138 @@
+package bucket
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	bucketops "github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/provider"
+	"github.com/spf13/cobra"
+)
+
+// NewAccessTemplateCmd creates the bucket access-template command
+func NewAccessTemplateCmd() *cobra.Command {
+	var (
+		cloud  string
+		format string
+		params provider.AccessParams
+	)
+
+	cmd := &cobra.Command{
+		Use:   "access-template",
+		Short: "Generate infrastructure that grants NStream access to a bucket",
+		Long: `Print infrastructure as code that creates a role for NStream in your cloud
+account and grants it read and write access to a bucket:
+
+  aws    an IAM role trusted by the NStream service role (cloudformation, terraform, json-policy)
+  gcp    a service account impersonated by the NStream service account (gcloud-script, terraform, json-policy)
+  azure  a managed identity federated with the NStream workload identity (bicep, terraform, json-policy)
+
+For s3compatible buckets, json-policy prints the IAM policy to attach to the
+user or role of the credentials secret on the storage server.
+
+The NStream service role is fetched from the platform. The cloud provider and
+bucket default to those of the current context. Values that are not given, such
+as the gcp project or the azure storage account, are left as inputs of the
+template; json-policy needs them up front.
+
+With --kms-key, the role is also granted the bucket's customer-managed key where
+the cloud provider requires it, and --format key-policy prints what to grant on
+the key itself: a key policy statement (aws) or a script that lets the storage
+service use the key (gcp, azure). key-policy defaults to the bucket's key.`,
+		Example: `  # CloudFormation stack for the current bucket
+  nsai bucket access-template --cloud aws > nstream-bucket-access.json
+
+  # Terraform for a GCP bucket
+  nsai bucket access-template --cloud gcp --bucket training-data --project my-project --format terraform
+
+  # Bicep for an Azure container
+  nsai bucket access-template --cloud azure --bucket training-data --storage-account nsaidata
+
+  # Key policy statement for the bucket's KMS key
+  nsai bucket access-template --cloud aws --bucket training-data --format key-policy`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			if cloud == "" {
+				cloud = session.Config.Cluster.CloudProvider
+			}
+			prov, err := provider.Get(cloud)
+			if err != nil {
+				return err
+			}
+			if format == "" {
+				format = provider.DefaultAccessFormat(prov)
+			}
+			// s3compatible buckets are reached with the keys in their credentials secret,
+			// so their role is only set when one is assumed
+			if !cmd.Flags().Changed("role") {
+				params.Role = prov.DefaultRole()
+			}
+			if params.Bucket == "" {
+				params.Bucket = session.Config.Cluster.Bucket
+			}
+			if params.Bucket == "" {
+				return fmt.Errorf("no bucket selected. Use --bucket or 'nsai use bucket'")
+			}
+			params.Cloud = cloud
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			ops := bucketops.NewOperationsWithClient(session.Client, session.Config)
+
+			// Only the key policy needs a key, so the bucket is only looked up for it
+			if format == "key-policy" && params.KMSKey == "" {
+				b, err := ops.GetBucket(ctx, params.Bucket)
+				if err != nil {
+					return err
+				}
+				if b.KmsKey == "" {
+					return fmt.Errorf("bucket '%s' has no customer-managed key. Pass one with --kms-key", params.Bucket)
+				}
+				params.KMSKey = b.KmsKey
+			}
+
+			// Providers reached with their own credentials have no NStream service role to trust
+			if prov.UsesServiceRole() {
+				role, err := ops.GetServiceRole(ctx, cloud)
+				if err != nil {
+					return err
+				}
+				params.ServiceRole = role.ServiceRole
+				params.ExternalID = role.ExternalId
+				params.Issuer = role.Issuer
+			}
+
+			out, err := provider.RenderAccessTemplate(format, params)
+			if err != nil {
+				return err
+			}
+
+			fmt.Print(out)
+			return nil
+		},
+	}
+
+	var formats []string
+	for _, prov := range provider.All() {
+		formats = append(formats, prov.Name()+": "+strings.Join(prov.AccessFormats(), ", "))
+	}
+
+	cmd.Flags().StringVar(&cloud, "cloud", "", "Cloud provider: "+strings.Join(provider.Names(), ", ")+" (defaults to the current context)")
+	cmd.Flags().StringVar(&format, "format", "", "Template format ("+strings.Join(formats, "; ")+"), the first is the default")
+	cmd.Flags().StringVar(&params.Bucket, "bucket", "", "Bucket to grant access to (defaults to the current context)")
+	cmd.Flags().StringVar(&params.Role, "role", provider.DefaultAccessRole, "Name of the role, service account or managed identity NStream uses")
+	cmd.Flags().StringVar(&params.KMSKey, "kms-key", "", "Customer-managed key of the bucket (KMS key ARN, Cloud KMS key name or Key Vault key URL)")
+	cmd.Flags().StringVar(&params.Project, "project", "", "GCP project of the service account")
+	cmd.Flags().StringVar(&params.Subscription, "subscription", "", "Azure subscription ID of the storage account")
+	cmd.Flags().StringVar(&params.ResourceGroup, "resource-group", "", "Azure resource group of the storage account")
+	cmd.Flags().StringVar(&params.StorageAccount, "storage-account", "", "Azure storage account of the container")
+
+	return cmd
+}
@@ -0,0 +1,73 @@
+package bucket
+
+import (
+	"fmt"
+	"strings"
+
+	bucketops "github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+// NewBucketCmd creates the root bucket command
+func NewBucketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bucket",
+		Short: "Work with objects in buckets and set up bucket access",
+		Long: `List, copy, print and delete the objects that the platform keeps in your
+buckets, such as checkpoints, archives and knowledge base source documents.
+
+Object paths are written as bucket://<bucket>/<key>. ls, cat and rm also accept
+a bare key, which refers to the bucket of the current context ('nsai use bucket').
+
+Every transfer goes through short-lived signed URLs issued by the platform, so no
+cloud credentials or provider CLIs are needed.
+
+access-template generates the infrastructure that grants NStream access to a
+bucket in your cloud account, and verify checks each permission it needs.`,
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		NewLsCmd(),
+		NewCpCmd(),
+		NewRmCmd(),
+		NewCatCmd(),
+		NewAccessTemplateCmd(),
+		NewVerifyCmd(),
+	)
+
+	return cmd
+}
+
+// isRemote reports whether path names an object rather than a local file
+func isRemote(path string) bool {
+	return strings.HasPrefix(path, bucketops.PathScheme)
+}
+
+// resolvePath returns the bucket and key of an object path. Paths without the bucket://
+// scheme are keys in the bucket of the current context.
+func resolvePath(cfg *config.Config, path string) (string, string, error) {
+	if isRemote(path) {
+		return bucketops.ParsePath(path)
+	}
+
+	if cfg.Cluster.Bucket == "" {
+		return "", "", fmt.Errorf("no bucket selected. Use bucket://<bucket>/<key> or 'nsai use bucket'")
+	}
+	return cfg.Cluster.Bucket, strings.TrimPrefix(path, "/"), nil
+}
+
+// objectURI renders an object path for display
+func objectURI(bucketName, key string) string {
+	return bucketops.PathScheme + bucketName + "/" + key
+}
+
+// dirPrefix returns key with a trailing slash, so that listing it does not also match
+// sibling prefixes such as run-420/ for run-42
+func dirPrefix(key string) string {
+	if key != "" && !strings.HasSuffix(key, "/") {
+		key += "/"
+	}
+	return key
+}
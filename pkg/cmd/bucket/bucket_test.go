@@ -0,0 +1,182 @@
+package bucket
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/bucket/buckettest"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+// runCommand runs cmd with args against the object store and returns what it printed to stdout
+func runCommand(t *testing.T, srv *buckettest.Server, cmd *cobra.Command, args ...string) (string, error) {
+	t.Helper()
+
+	session := &auth.Session{
+		Client: &client.Client{BucketClient: srv.Client()},
+		Config: &config.Config{
+			User:    config.UserConfig{AuthToken: "token"},
+			Cluster: config.ClusterConfig{Bucket: "data"},
+		},
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	out := make(chan string)
+	go func() {
+		b, _ := io.ReadAll(r)
+		out <- string(b)
+	}()
+
+	cmd.SetArgs(args)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	err = cmd.ExecuteContext(auth.NewContext(context.Background(), session))
+
+	w.Close()
+	return <-out, err
+}
+
+func TestCat(t *testing.T) {
+	srv := buckettest.NewServer(t)
+	srv.Put("data", "a.txt", []byte("first\n"))
+	srv.Put("other", "b.txt", []byte("second\n"))
+
+	out, err := runCommand(t, srv, NewCatCmd(), "a.txt", "bucket://other/b.txt")
+	if err != nil {
+		t.Fatalf("cat error = %v", err)
+	}
+	if out != "first\nsecond\n" {
+		t.Errorf("cat printed %q, want both objects in order", out)
+	}
+
+	if _, err := runCommand(t, srv, NewCatCmd(), "missing.txt"); err == nil {
+		t.Error("cat of a missing object succeeded")
+	}
+}
+
+func TestRm(t *testing.T) {
+	srv := buckettest.NewServer(t)
+	srv.Put("data", "a.txt", []byte("a"))
+	srv.Put("data", "run/1.ckpt", []byte("1"))
+	srv.Put("data", "run/2.ckpt", []byte("2"))
+	srv.Put("data", "keep.txt", []byte("keep"))
+	srv.Put("data", "run-420/keep.ckpt", []byte("keep"))
+
+	out, err := runCommand(t, srv, NewRmCmd(), "bucket://data/a.txt")
+	if err != nil {
+		t.Fatalf("rm error = %v", err)
+	}
+	if !strings.Contains(out, "deleted bucket://data/a.txt") {
+		t.Errorf("rm printed %q", out)
+	}
+	if _, ok := srv.Object("data", "a.txt"); ok {
+		t.Error("rm did not delete the object")
+	}
+
+	// Prefixes are only deleted with --recursive
+	if _, err := runCommand(t, srv, NewRmCmd(), "run/"); err == nil || !strings.Contains(err.Error(), "use --recursive") {
+		t.Errorf("rm of a prefix error = %v, want a --recursive hint", err)
+	}
+
+	// A prefix without a trailing slash does not match sibling prefixes
+	srv.Put("data", "run-42/1.ckpt", []byte("1"))
+	if _, err := runCommand(t, srv, NewRmCmd(), "-r", "-f", "run-42"); err != nil {
+		t.Fatalf("rm -r error = %v", err)
+	}
+	if _, ok := srv.Object("data", "run-42/1.ckpt"); ok {
+		t.Error("rm -r did not delete run-42/1.ckpt")
+	}
+
+	if _, err := runCommand(t, srv, NewRmCmd(), "-r", "-f", "run/"); err != nil {
+		t.Fatalf("rm -r error = %v", err)
+	}
+	for _, key := range []string{"run/1.ckpt", "run/2.ckpt"} {
+		if _, ok := srv.Object("data", key); ok {
+			t.Errorf("rm -r did not delete %s", key)
+		}
+	}
+	for _, key := range []string{"keep.txt", "run-420/keep.ckpt"} {
+		if _, ok := srv.Object("data", key); !ok {
+			t.Errorf("rm -r deleted %s outside of the prefix", key)
+		}
+	}
+}
+
+func TestCpRecursiveDownload(t *testing.T) {
+	srv := buckettest.NewServer(t)
+	srv.Put("data", "run/1.ckpt", []byte("1"))
+	srv.Put("data", "run/sub/2.ckpt", []byte("2"))
+
+	dst := filepath.Join(t.TempDir(), "out")
+	if _, err := runCommand(t, srv, NewCpCmd(), "-r", "bucket://data/run", dst); err != nil {
+		t.Fatalf("cp -r error = %v", err)
+	}
+
+	for rel, want := range map[string]string{"1.ckpt": "1", "sub/2.ckpt": "2"} {
+		got, err := os.ReadFile(filepath.Join(dst, filepath.FromSlash(rel)))
+		if err != nil || string(got) != want {
+			t.Errorf("%s = %q, %v, want %q", rel, got, err, want)
+		}
+	}
+}
+
+func TestCpRecursiveDownloadSkipsSiblingPrefixes(t *testing.T) {
+	srv := buckettest.NewServer(t)
+	srv.Put("data", "run-42/1.ckpt", []byte("1"))
+	srv.Put("data", "run-420/keep.ckpt", []byte("other run"))
+
+	dst := filepath.Join(t.TempDir(), "out")
+	if _, err := runCommand(t, srv, NewCpCmd(), "-r", "bucket://data/run-42", dst); err != nil {
+		t.Fatalf("cp -r error = %v", err)
+	}
+
+	var files []string
+	filepath.WalkDir(dst, func(p string, d os.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			rel, _ := filepath.Rel(dst, p)
+			files = append(files, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if len(files) != 1 || files[0] != "1.ckpt" {
+		t.Errorf("cp -r downloaded %v, want only 1.ckpt", files)
+	}
+}
+
+func TestCpRecursiveDownloadStaysInDestination(t *testing.T) {
+	for _, key := range []string{"run/../escape.txt", "run/sub/../../../escape.txt", "run/.."} {
+		t.Run(key, func(t *testing.T) {
+			srv := buckettest.NewServer(t)
+			srv.Put("data", "run/ok.txt", []byte("ok"))
+			srv.Put("data", key, []byte("escaped"))
+
+			root := t.TempDir()
+			dst := filepath.Join(root, "a", "b")
+			_, err := runCommand(t, srv, NewCpCmd(), "-r", "bucket://data/run/", dst)
+			if err == nil || !strings.Contains(err.Error(), "refusing to download") {
+				t.Fatalf("cp -r error = %v, want a refusal", err)
+			}
+
+			filepath.WalkDir(root, func(p string, d os.DirEntry, err error) error {
+				if err == nil && !d.IsDir() && !strings.HasPrefix(p, dst+string(filepath.Separator)) {
+					t.Errorf("cp -r wrote %s outside of %s", p, dst)
+				}
+				return nil
+			})
+		})
+	}
+}
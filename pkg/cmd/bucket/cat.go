@@ -0,0 +1,43 @@
+package bucket
+
+import (
+	"os"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	bucketops "github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	"github.com/spf13/cobra"
+)
+
+// NewCatCmd creates the bucket cat command
+func NewCatCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cat <path>...",
+		Short: "Print objects to stdout",
+		Long:  `Print the contents of one or more objects to stdout, in the order given`,
+		Example: `  # Inspect a knowledge base source document
+  nsai bucket cat kb/sources/handbook.md | less`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ops := bucketops.NewOperationsWithClient(session.Client, session.Config)
+
+			for _, path := range args {
+				bucketName, key, err := resolvePath(session.Config, path)
+				if err != nil {
+					return err
+				}
+
+				if err := ops.DownloadObject(cmd.Context(), bucketName, key, os.Stdout, nil); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
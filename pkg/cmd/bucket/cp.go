@@ -0,0 +1,254 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	bucketops "github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// copier copies files between the local filesystem and a bucket
+type copier struct {
+	ops      *bucketops.Operations
+	opts     bucketops.UploadOptions
+	progress bool
+}
+
+// NewCpCmd creates the bucket cp command
+func NewCpCmd() *cobra.Command {
+	var (
+		recursive   bool
+		partSizeMiB int64
+		concurrency int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "cp <source> <destination>",
+		Short: "Upload or download objects",
+		Long: `Copy a local file to a bucket or an object to a local file. Exactly one of
+source and destination must be a bucket://<bucket>/<key> path.
+
+If the destination key is empty or ends with '/', or the local destination is
+a directory, the source's base name is appended. A local destination of '-'
+writes the object to stdout.
+
+With --recursive, the contents of a local directory are uploaded under the
+destination prefix, or every object under the source prefix is downloaded into
+the destination directory.
+
+Files larger than --part-size are uploaded as multipart uploads with
+--concurrency parts in flight. Progress is shown when stderr is a terminal.`,
+		Example: `  # Upload a source document
+  nsai bucket cp ./handbook.md bucket://training-data/kb/sources/
+
+  # Download a checkpoint directory
+  nsai bucket cp -r bucket://training-data/checkpoints/run-42/ ./run-42`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			src, dst := args[0], args[1]
+			if isRemote(src) == isRemote(dst) {
+				return fmt.Errorf("exactly one of source and destination must be a %s<bucket>/<key> path", bucketops.PathScheme)
+			}
+			if partSizeMiB*(1<<20) < bucketops.MinPartSize {
+				return fmt.Errorf("--part-size must be at least %d MiB", bucketops.MinPartSize>>20)
+			}
+			if concurrency < 1 {
+				return fmt.Errorf("--concurrency must be at least 1")
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			c := &copier{
+				ops: bucketops.NewOperationsWithClient(session.Client, session.Config),
+				opts: bucketops.UploadOptions{
+					PartSize:    partSizeMiB << 20,
+					Concurrency: concurrency,
+				},
+				progress: utils.IsTerminal(os.Stderr),
+			}
+
+			if isRemote(dst) {
+				bucketName, key, err := bucketops.ParsePath(dst)
+				if err != nil {
+					return err
+				}
+				return c.upload(cmd.Context(), src, bucketName, key, recursive)
+			}
+
+			bucketName, key, err := bucketops.ParsePath(src)
+			if err != nil {
+				return err
+			}
+			return c.download(cmd.Context(), bucketName, key, dst, recursive)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&recursive, "recursive", "r", false, "Copy directories and prefixes recursively")
+	cmd.Flags().Int64Var(&partSizeMiB, "part-size", bucketops.DefaultPartSize>>20, "Multipart upload part size in MiB")
+	cmd.Flags().IntVar(&concurrency, "concurrency", bucketops.DefaultConcurrency, "Number of parts uploaded in parallel")
+
+	return cmd
+}
+
+func (c *copier) upload(ctx context.Context, src, bucketName, key string, recursive bool) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %v", src, err)
+	}
+
+	if !info.IsDir() {
+		if key == "" || strings.HasSuffix(key, "/") {
+			key += filepath.Base(src)
+		}
+		return c.uploadFile(ctx, src, bucketName, key)
+	}
+
+	if !recursive {
+		return fmt.Errorf("%s is a directory, use --recursive to upload it", src)
+	}
+
+	prefix := dirPrefix(key)
+
+	return filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		return c.uploadFile(ctx, p, bucketName, prefix+filepath.ToSlash(rel))
+	})
+}
+
+func (c *copier) uploadFile(ctx context.Context, src, bucketName, key string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %v", src, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %v", src, err)
+	}
+
+	opts := c.opts
+	opts.ContentType = mime.TypeByExtension(filepath.Ext(src))
+
+	var bar *utils.ProgressBar
+	if c.progress {
+		bar = utils.NewProgressBar(os.Stderr, src, info.Size())
+		opts.Progress = bar
+	}
+
+	err = c.ops.UploadObject(ctx, bucketName, key, f, info.Size(), opts)
+	if bar != nil {
+		bar.Finish()
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("uploaded %s to %s\n", src, objectURI(bucketName, key))
+	return nil
+}
+
+func (c *copier) download(ctx context.Context, bucketName, key, dst string, recursive bool) error {
+	if !recursive {
+		if key == "" || strings.HasSuffix(key, "/") {
+			return fmt.Errorf("%s is a prefix, use --recursive to download the objects under it", objectURI(bucketName, key))
+		}
+		if dst == "-" {
+			return c.ops.DownloadObject(ctx, bucketName, key, os.Stdout, nil)
+		}
+		if info, err := os.Stat(dst); (err == nil && info.IsDir()) || strings.HasSuffix(dst, string(filepath.Separator)) {
+			dst = filepath.Join(dst, path.Base(key))
+		}
+		return c.downloadFile(ctx, bucketName, key, dst)
+	}
+
+	if dst == "-" {
+		return fmt.Errorf("cannot download recursively to stdout")
+	}
+
+	prefix := dirPrefix(key)
+	objects, err := c.ops.IterateObjects(bucketName, prefix, "", client.ListOptions{PageSize: 1000}).All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(objects) == 0 {
+		return fmt.Errorf("no objects found under %s", objectURI(bucketName, prefix))
+	}
+
+	for _, obj := range objects {
+		rel := strings.TrimPrefix(obj.Key, prefix)
+		if rel == "" || strings.HasSuffix(rel, "/") {
+			continue
+		}
+
+		// Never write outside the destination, whatever the object keys contain
+		target := filepath.Join(dst, filepath.FromSlash(rel))
+		if r, err := filepath.Rel(dst, target); err != nil || r == ".." || strings.HasPrefix(r, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("refusing to download %s outside of %s", objectURI(bucketName, obj.Key), dst)
+		}
+
+		if err := c.downloadFile(ctx, bucketName, obj.Key, target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *copier) downloadFile(ctx context.Context, bucketName, key, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %v", filepath.Dir(dst), err)
+	}
+
+	f, err := os.Create(dst)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %v", dst, err)
+	}
+
+	var (
+		progress bucketops.Progress
+		bar      *utils.ProgressBar
+	)
+	if c.progress {
+		bar = utils.NewProgressBar(os.Stderr, dst, 0)
+		progress = bar
+	}
+
+	err = c.ops.DownloadObject(ctx, bucketName, key, f, progress)
+	if bar != nil {
+		bar.Finish()
+	}
+	if closeErr := f.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to write %s: %v", dst, closeErr)
+	}
+	if err != nil {
+		// Do not leave a truncated file behind
+		os.Remove(dst)
+		return err
+	}
+
+	fmt.Printf("downloaded %s to %s\n", objectURI(bucketName, key), dst)
+	return nil
+}
@@ -0,0 +1,108 @@
+package bucket
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	bucketops "github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// Long listings use fixed-width columns so rows printed page by page stay aligned
+const longFormat = "%10s  %16s  %s\n"
+
+// NewLsCmd creates the bucket ls command
+func NewLsCmd() *cobra.Command {
+	var (
+		recursive bool
+		long      bool
+		listOpts  client.ListOptions
+	)
+
+	cmd := &cobra.Command{
+		Use:   "ls [prefix]",
+		Short: "List objects",
+		Long: `List the objects under a prefix, one level at a time. Keys that continue past
+the next '/' are grouped into a single entry ending in '/', like directories.
+
+Use --recursive to list every object under the prefix and --long to include
+sizes and modification times. Objects are printed as each page arrives.`,
+		Example: `  # Top level of the current bucket
+  nsai bucket ls
+
+  # Every checkpoint with sizes
+  nsai bucket ls bucket://training-data/checkpoints/ -r -l`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := listOpts.Validate(); err != nil {
+				return err
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			path := ""
+			if len(args) > 0 {
+				path = args[0]
+			}
+			bucketName, prefix, err := resolvePath(session.Config, path)
+			if err != nil {
+				return err
+			}
+
+			delimiter := "/"
+			if recursive {
+				delimiter = ""
+			}
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			it := bucketops.NewOperationsWithClient(session.Client, session.Config).IterateObjects(bucketName, prefix, delimiter, listOpts)
+
+			count := 0
+			for {
+				objects, err := it.NextPage(ctx)
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return err
+				}
+
+				for _, obj := range objects {
+					count++
+					if !long {
+						fmt.Println(obj.Key)
+						continue
+					}
+
+					if strings.HasSuffix(obj.Key, "/") && obj.LastModified == nil {
+						fmt.Printf(longFormat, "DIR", "", obj.Key)
+						continue
+					}
+					fmt.Printf(longFormat, utils.FormatBytes(obj.Size), obj.LastModified.AsTime().Local().Format("2006-01-02 15:04"), obj.Key)
+				}
+			}
+
+			if count == 0 {
+				fmt.Fprintf(os.Stderr, "No objects found under %s\n", objectURI(bucketName, prefix))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&recursive, "recursive", "r", false, "List every object under the prefix")
+	cmd.Flags().BoolVarP(&long, "long", "l", false, "Show sizes and modification times")
+	cmd.Flags().IntVar(&listOpts.Limit, "limit", 0, "Maximum number of entries to list (0 lists all)")
+	cmd.Flags().Int32Var(&listOpts.PageSize, "chunk-size", 1000, "Number of entries fetched per request")
+
+	return cmd
+}
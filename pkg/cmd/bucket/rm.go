@@ -0,0 +1,110 @@
+package bucket
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	bucketops "github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// NewRmCmd creates the bucket rm command
+func NewRmCmd() *cobra.Command {
+	var (
+		recursive bool
+		force     bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "rm <path>...",
+		Short: "Delete objects",
+		Long: `Delete one or more objects.
+
+With --recursive, every object under each path is deleted. You will be asked to
+confirm before a recursive delete unless --force is given.`,
+		Example: `  # Delete one archive
+  nsai bucket rm bucket://training-data/archives/2024-01.tar.gz
+
+  # Delete all checkpoints of a run
+  nsai bucket rm -r checkpoints/run-42/`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ops := bucketops.NewOperationsWithClient(session.Client, session.Config)
+
+			for _, path := range args {
+				bucketName, key, err := resolvePath(session.Config, path)
+				if err != nil {
+					return err
+				}
+
+				if !recursive {
+					if key == "" || strings.HasSuffix(key, "/") {
+						return fmt.Errorf("%s is a prefix, use --recursive to delete the objects under it", objectURI(bucketName, key))
+					}
+					if err := ops.DeleteObject(cmd.Context(), bucketName, key); err != nil {
+						return err
+					}
+					fmt.Printf("deleted %s\n", objectURI(bucketName, key))
+					continue
+				}
+
+				key = dirPrefix(key)
+				objects, err := ops.IterateObjects(bucketName, key, "", client.ListOptions{PageSize: 1000}).All(cmd.Context())
+				if err != nil {
+					return err
+				}
+				if len(objects) == 0 {
+					fmt.Printf("No objects found under %s\n", objectURI(bucketName, key))
+					continue
+				}
+
+				if !force {
+					confirmed, err := confirm(fmt.Sprintf("Delete %d object(s) under %s?", len(objects), objectURI(bucketName, key)))
+					if err != nil {
+						return err
+					}
+					if !confirmed {
+						return fmt.Errorf("deletion cancelled")
+					}
+				}
+
+				for _, obj := range objects {
+					if err := ops.DeleteObject(cmd.Context(), bucketName, obj.Key); err != nil {
+						return err
+					}
+					fmt.Printf("deleted %s\n", objectURI(bucketName, obj.Key))
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&recursive, "recursive", "r", false, "Delete every object under the given prefixes")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Delete recursively without confirmation")
+
+	return cmd
+}
+
+// confirm asks a yes/no question, defaulting to no
+func confirm(question string) (bool, error) {
+	fmt.Printf("%s%s%s (y/N): ", utils.BoldColor, question, utils.ResetColor)
+
+	reader := bufio.NewReader(os.Stdin)
+	answer, err := reader.ReadString('\n')
+	if err != nil {
+		return false, fmt.Errorf("failed to read input: %v", err)
+	}
+
+	answer = strings.TrimSpace(strings.ToLower(answer))
+	return answer == "y" || answer == "yes", nil
+}
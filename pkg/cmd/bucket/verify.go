@@ -0,0 +1,118 @@
+package bucket
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	bucketops "github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/printer"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/provider"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+	"github.com/spf13/cobra"
+)
+
+// NewVerifyCmd creates the bucket verify command
+func NewVerifyCmd() *cobra.Command {
+	var (
+		cloud        string
+		role         string
+		outputFormat string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "verify [bucket]",
+		Short: "Check that NStream can access a bucket",
+		Long: `Check each permission NStream needs on a bucket through the access role:
+assuming the role, listing, reading, writing and deleting objects, and
+decrypting with the bucket's KMS key when it has one.
+
+Every check is printed with its status and the cloud provider's error code,
+followed by a hint for each failed check. The bucket, cloud provider and role
+default to those of the current context. The command exits with an error when
+any check fails.`,
+		Example: `  # Verify the bucket of the current context
+  nsai bucket verify
+
+  # Verify another bucket through a specific role
+  nsai bucket verify training-data --role nstream-bucket-access`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := printer.ValidateFormat(outputFormat, printer.FormatTable, printer.FormatJSON, printer.FormatYAML); err != nil {
+				return err
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+			cfg := session.Config
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			ops := bucketops.NewOperationsWithClient(session.Client, cfg)
+
+			bucketName := cfg.Cluster.Bucket
+			if len(args) > 0 {
+				bucketName = args[0]
+			}
+			if bucketName == "" {
+				return fmt.Errorf("no bucket selected. Pass a bucket name or use 'nsai use bucket'")
+			}
+
+			// The context's cloud provider and role only apply to the context's bucket
+			inContext := bucketName == cfg.Cluster.Bucket
+			if cloud == "" {
+				if inContext && cfg.Cluster.CloudProvider != "" {
+					cloud = cfg.Cluster.CloudProvider
+				} else {
+					b, err := ops.GetBucket(ctx, bucketName)
+					if err != nil {
+						return err
+					}
+					cloud = b.Provider
+				}
+			}
+			// s3compatible buckets are accessed with the keys of their credentials secret
+			// unless they assume a role
+			prov, err := provider.Get(cloud)
+			if err != nil {
+				return err
+			}
+			if role == "" {
+				role = prov.DefaultRole()
+			}
+			if !cmd.Flags().Changed("role") && inContext && cfg.Cluster.Role != "" {
+				role = cfg.Cluster.Role
+			}
+
+			resp, err := ops.VerifyAccess(ctx, cloud, bucketName, role)
+			if err != nil {
+				return err
+			}
+
+			if outputFormat == printer.FormatTable {
+				if role != "" {
+					fmt.Printf("Verifying access to %s bucket '%s' through '%s'\n\n", cloud, bucketName, role)
+				} else {
+					fmt.Printf("Verifying access to %s bucket '%s'\n\n", cloud, bucketName)
+				}
+				bucketops.WriteAccessChecks(os.Stdout, cloud, bucketName, role, resp)
+			} else if err := printer.PrintItem(os.Stdout, outputFormat, resp, printer.Options[*clusterproto.VerifyBucketAccessResponse]{}); err != nil {
+				return err
+			}
+
+			if !resp.HasAccess {
+				return fmt.Errorf("bucket access verification failed")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&cloud, "cloud", "", "Cloud provider of the bucket (defaults to the current context or the bucket's provider)")
+	cmd.Flags().StringVar(&role, "role", "", "Role, service account or managed identity NStream uses (defaults to the current context)")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", printer.FormatTable, printer.FormatHelp(printer.FormatTable, printer.FormatJSON, printer.FormatYAML))
+
+	return cmd
+}
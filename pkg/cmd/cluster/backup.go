@@ -0,0 +1,106 @@
+package cluster
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/backup"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	clusterops "github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// NewBackupCmd creates the cluster backup command
+func NewBackupCmd() *cobra.Command {
+	var (
+		to   string
+		file string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "backup <cluster-name>",
+		Short: "Back up the resource definitions of a cluster",
+		Long: `Write a versioned archive of every stream graph, connector, model, finetuner
+and knowledgebase spec defined on a cluster. Secret values are never included.
+
+The archive is written to --file, or uploaded to a bucket path with
+--to bucket://<bucket>/<key>. A key ending in '/' is treated as a folder.
+Without either flag the archive is saved in the current directory. Restore it
+with 'nsai cluster restore --from', which takes the same paths.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			// Bucket paths are spelled the same as for restore --from and bucket cp
+			if to != "" && !strings.HasPrefix(to, bucket.PathScheme) {
+				return fmt.Errorf("invalid --to value %q (expected %s<bucket>/<key>)", to, bucket.PathScheme)
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			done := make(chan bool)
+			go utils.ShowDefaultLoading(fmt.Sprintf("Exporting resources of cluster '%s'", name), done)
+			resources, err := clusterops.NewOperationsWithClient(session.Client, session.Config).ExportResources(ctx, name)
+			done <- true
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			var buf bytes.Buffer
+			if err := backup.Write(&buf, name, now, resources); err != nil {
+				return err
+			}
+
+			var destination string
+			if to != "" {
+				bucketName, key, err := bucket.ParsePath(to)
+				if err != nil {
+					return err
+				}
+				if key == "" || strings.HasSuffix(key, "/") {
+					key += backup.FileName(name, now)
+				}
+
+				done := make(chan bool)
+				go utils.ShowDefaultLoading("Uploading archive", done)
+				err = bucket.NewOperationsWithClient(session.Client, session.Config).PutObject(ctx, bucketName, key, buf.Bytes())
+				done <- true
+				if err != nil {
+					return err
+				}
+				destination = bucket.PathScheme + bucketName + "/" + key
+			} else {
+				destination = file
+				if destination == "" {
+					destination = backup.FileName(name, now)
+				}
+				if err := os.WriteFile(destination, buf.Bytes(), 0600); err != nil {
+					return fmt.Errorf("failed to save backup: %v", err)
+				}
+			}
+
+			fmt.Printf("\n%s✓ Backed up %d resources of cluster '%s'%s\n", utils.BoldColor, len(resources), name, utils.ResetColor)
+			fmt.Printf("  Archive: %s\n", destination)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&to, "to", "", "Bucket path to upload the archive to (bucket://<bucket>/<key>)")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Local file to write the archive to")
+
+	cmd.MarkFlagsMutuallyExclusive("to", "file")
+
+	return cmd
+}
@@ -0,0 +1,269 @@
+package cluster
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	bucketops "github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	clusterops "github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/printer"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+	"github.com/spf13/cobra"
+)
+
+// NewBucketCmd creates the cluster bucket command
+func NewBucketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bucket",
+		Short: "Manage the buckets attached to a cluster",
+		Long: `Attach, detach and list the buckets a cluster uses.
+
+Each bucket is attached for a purpose, so training data, checkpoints, logs and
+knowledge base sources can live in separate buckets with their own retention
+rules:
+
+  data         training data; the bucket the cluster was created with
+  checkpoints  model checkpoints
+  logs         cluster and job logs
+  kb           knowledge base source documents
+
+A cluster has at most one bucket per purpose, and a bucket can be attached for
+several purposes.`,
+	}
+
+	cmd.AddCommand(
+		newBucketAttachCmd(),
+		newBucketDetachCmd(),
+		newBucketListCmd(),
+	)
+
+	return cmd
+}
+
+func newBucketAttachCmd() *cobra.Command {
+	var (
+		bucketName string
+		purpose    string
+		role       string
+		replace    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "attach <cluster-name>",
+		Short: "Attach a bucket to a cluster",
+		Long: `Attach a bucket to a cluster for a purpose.
+
+The bucket must be on the cluster's cloud provider. Access through the role is
+verified before the bucket is attached, and failed permission checks are shown
+with hints. The role defaults to the cluster's role. Use --replace to swap out
+the bucket currently attached for the purpose.`,
+		Example: `  # Keep checkpoints in their own bucket
+  nsai cluster bucket attach my-cluster --bucket my-checkpoints --purpose checkpoints`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clusterName := args[0]
+
+			if err := clusterops.ValidatePurpose(purpose); err != nil {
+				return err
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			ops := clusterops.NewOperationsWithClient(session.Client, session.Config)
+			bucketOps := bucketops.NewOperationsWithClient(session.Client, session.Config)
+
+			details, err := ops.GetClusterDetails(ctx, clusterName)
+			if err != nil {
+				return err
+			}
+			b, err := bucketOps.GetBucket(ctx, bucketName)
+			if err != nil {
+				return err
+			}
+			if b.Provider != details.CloudProvider {
+				return fmt.Errorf("cloud provider mismatch: cluster '%s' uses '%s' but bucket '%s' uses '%s'",
+					clusterName, details.CloudProvider, bucketName, b.Provider)
+			}
+			if role == "" {
+				role = details.Role
+			}
+
+			// Each attachment is verified on its own, since it may use a different role
+			done := make(chan bool)
+			go utils.ShowDefaultLoading("Verifying bucket access", done)
+			accessResp, err := bucketOps.VerifyAccess(ctx, details.CloudProvider, bucketName, role)
+			done <- true
+			if err != nil {
+				return err
+			}
+			if !accessResp.HasAccess {
+				fmt.Println()
+				bucketops.WriteAccessChecks(os.Stdout, details.CloudProvider, bucketName, role, accessResp)
+				return fmt.Errorf("bucket access verification failed")
+			}
+
+			if _, err := ops.AttachBucket(ctx, clusterName, bucketName, purpose, role, replace); err != nil {
+				return err
+			}
+
+			fmt.Printf("%s✓ Bucket '%s' attached to cluster '%s' for %s%s\n", utils.BoldColor, bucketName, clusterName, purpose, utils.ResetColor)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&bucketName, "bucket", "b", "", "Bucket to attach")
+	cmd.Flags().StringVar(&purpose, "purpose", "", "Purpose of the bucket ("+strings.Join(clusterops.BucketPurposes, ", ")+")")
+	cmd.Flags().StringVarP(&role, "role", "p", "", "Role/principal used to access the bucket (defaults to the cluster's role)")
+	cmd.Flags().BoolVar(&replace, "replace", false, "Replace the bucket already attached for the purpose")
+	cmd.MarkFlagRequired("bucket")
+	cmd.MarkFlagRequired("purpose")
+
+	return cmd
+}
+
+func newBucketDetachCmd() *cobra.Command {
+	var (
+		bucketName string
+		purpose    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "detach <cluster-name>",
+		Short: "Detach buckets from a cluster",
+		Long: `Detach buckets from a cluster. The buckets and their objects are kept.
+
+With --purpose, the bucket attached for that purpose is detached. With
+--bucket, the bucket is detached from every purpose it is attached for. With
+both, only that attachment is removed.`,
+		Example: `  # Stop writing logs to a bucket
+  nsai cluster bucket detach my-cluster --purpose logs`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clusterName := args[0]
+
+			if purpose != "" {
+				if err := clusterops.ValidatePurpose(purpose); err != nil {
+					return err
+				}
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+			cfg := session.Config
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			ops := clusterops.NewOperationsWithClient(session.Client, cfg)
+
+			detached, err := ops.DetachBucket(ctx, clusterName, bucketName, purpose)
+			if err != nil {
+				return err
+			}
+			if len(detached) == 0 {
+				return fmt.Errorf("no matching bucket is attached to cluster '%s'", clusterName)
+			}
+
+			for _, a := range detached {
+				fmt.Printf("bucket '%s' detached from cluster '%s' (%s)\n", a.Bucket, clusterName, a.Purpose)
+			}
+
+			// Clear the bucket context if the bucket is no longer attached for any purpose
+			if cfg.Cluster.Name != clusterName || cfg.Cluster.Bucket == "" {
+				return nil
+			}
+			remaining, err := ops.ListAttachedBuckets(ctx, clusterName)
+			if err != nil {
+				return err
+			}
+			for _, a := range remaining {
+				if a.Bucket == cfg.Cluster.Bucket {
+					return nil
+				}
+			}
+			cfg.Cluster.Bucket = ""
+			if err := config.SaveConfig(cfg); err != nil {
+				return fmt.Errorf("failed to save config: %v", err)
+			}
+			fmt.Println("Cleared the bucket context. Use 'nsai use bucket' to select another bucket.")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&bucketName, "bucket", "b", "", "Bucket to detach")
+	cmd.Flags().StringVar(&purpose, "purpose", "", "Purpose to detach the bucket from ("+strings.Join(clusterops.BucketPurposes, ", ")+")")
+	cmd.MarkFlagsOneRequired("bucket", "purpose")
+
+	return cmd
+}
+
+func newBucketListCmd() *cobra.Command {
+	var outputFormat string
+
+	cmd := &cobra.Command{
+		Use:   "list <cluster-name>",
+		Short: "List the buckets attached to a cluster",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clusterName := args[0]
+
+			if err := printer.ValidateFormat(outputFormat); err != nil {
+				return err
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			attachments, err := clusterops.NewOperationsWithClient(session.Client, session.Config).ListAttachedBuckets(ctx, clusterName)
+			if err != nil {
+				return err
+			}
+
+			if len(attachments) == 0 && (outputFormat == printer.FormatTable || outputFormat == printer.FormatWide) {
+				fmt.Printf("No buckets attached to cluster '%s'.\n", clusterName)
+				return nil
+			}
+			return printer.PrintList(os.Stdout, outputFormat, attachments, attachmentPrintOptions())
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", printer.FormatTable, printer.FormatHelp())
+
+	return cmd
+}
+
+// attachmentPrintOptions returns the printer options for bucket attachments
+func attachmentPrintOptions() printer.Options[*clusterproto.BucketAttachment] {
+	return printer.Options[*clusterproto.BucketAttachment]{
+		Kind: "bucket",
+		Name: func(a *clusterproto.BucketAttachment) string { return a.Bucket },
+		Columns: []printer.Column[*clusterproto.BucketAttachment]{
+			{Header: "PURPOSE", Value: func(a *clusterproto.BucketAttachment) string { return a.Purpose }},
+			{Header: "BUCKET", Value: func(a *clusterproto.BucketAttachment) string { return a.Bucket }},
+			{Header: "ROLE", Value: func(a *clusterproto.BucketAttachment) string { return a.Role }},
+			{Header: "ATTACHED", Wide: true, Value: func(a *clusterproto.BucketAttachment) string {
+				if a.AttachedAt == nil {
+					return ""
+				}
+				return a.AttachedAt.AsTime().Local().Format("2006-01-02 15:04")
+			}},
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package cluster
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewClusterCmd creates the root cluster command
+func NewClusterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cluster",
+		Short: "Manage NStream AI clusters",
+		Long:  `Manage the lifecycle of existing NStream AI clusters`,
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		NewUpgradeCmd(),
+		NewDowngradeCmd(),
+		NewPauseCmd(),
+		NewResumeCmd(),
+		NewScaleCmd(),
+		NewBackupCmd(),
+		NewRestoreCmd(),
+		NewBucketCmd(),
+	)
+
+	return cmd
+}
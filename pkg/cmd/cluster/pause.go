@@ -0,0 +1,79 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	clusterops "github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// NewPauseCmd creates the cluster pause command
+func NewPauseCmd() *cobra.Command {
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "pause <cluster-name>",
+		Short: "Pause a cluster",
+		Long: `Pause a cluster to stop paying for its compute while it is idle.
+
+The cluster's bucket, models and knowledgebases are kept. A paused cluster
+shows the 'paused' phase in 'nsai get cluster' and can be brought back with
+'nsai cluster resume'. The command waits for the pause to finish.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ops := clusterops.NewOperationsWithClient(session.Client, session.Config)
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			details, err := ops.GetClusterDetails(ctx, name)
+			if err != nil {
+				return err
+			}
+			if details.Phase == clusterops.PhasePaused {
+				return fmt.Errorf("cluster '%s' is already paused", name)
+			}
+
+			op, err := ops.PauseCluster(ctx, name)
+			if err != nil {
+				return err
+			}
+
+			if err := waitForOperation(cmd.Context(), ops, op.Id, timeout); err != nil {
+				return err
+			}
+
+			fmt.Printf("\n%s✓ Cluster '%s' is paused%s\n", utils.BoldColor, name, utils.ResetColor)
+			fmt.Printf("Run 'nsai cluster resume %s' to start it again.\n", name)
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "Maximum time to wait for the pause to finish")
+
+	return cmd
+}
+
+// waitForOperation follows a long-running cluster operation on a single progress line
+func waitForOperation(ctx context.Context, ops *clusterops.Operations, operationID string, timeout time.Duration) error {
+	waitCtx, waitCancel := context.WithTimeout(ctx, timeout)
+	defer waitCancel()
+
+	fmt.Println()
+	display := clusterops.NewProgressDisplay(os.Stdout)
+	_, err := ops.WaitForOperation(waitCtx, operationID, 2*time.Second, display.UpdateOperation)
+	display.Finish()
+	return err
+}
@@ -0,0 +1,162 @@
+package cluster
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/backup"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	clusterops "github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// Conflict policies for restore
+const (
+	conflictSkip      = "skip"
+	conflictOverwrite = "overwrite"
+	conflictFail      = "fail"
+)
+
+// NewRestoreCmd creates the cluster restore command
+func NewRestoreCmd() *cobra.Command {
+	var (
+		from       string
+		into       string
+		onConflict string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore resource definitions from a backup",
+		Long: `Recreate the resources of a backup archive on a cluster, in dependency order.
+
+--from is a local file or a bucket path given as bucket://<bucket>/<key>.
+--on-conflict decides what happens to resources that already exist on the
+target cluster:
+  fail       stop before changing anything (default)
+  skip       keep the existing resource
+  overwrite  replace the existing resource with the backed-up spec
+
+Secrets are not part of backups and must be recreated separately.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch onConflict {
+			case conflictSkip, conflictOverwrite, conflictFail:
+			default:
+				return fmt.Errorf("invalid --on-conflict value %q (must be skip, overwrite or fail)", onConflict)
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			var data []byte
+			if strings.HasPrefix(from, bucket.PathScheme) {
+				bucketName, key, err := bucket.ParsePath(from)
+				if err != nil {
+					return err
+				}
+				ctx, cancel := session.Client.WithContext(cmd.Context())
+				done := make(chan bool)
+				go utils.ShowDefaultLoading("Downloading archive", done)
+				data, err = bucket.NewOperationsWithClient(session.Client, session.Config).GetObject(ctx, bucketName, key)
+				done <- true
+				cancel()
+				if err != nil {
+					return err
+				}
+			} else {
+				f, err := os.Open(from)
+				if err != nil {
+					return fmt.Errorf("failed to open backup: %v", err)
+				}
+				data, err = io.ReadAll(f)
+				f.Close()
+				if err != nil {
+					return fmt.Errorf("failed to read backup: %v", err)
+				}
+			}
+
+			archive, err := backup.Read(bytes.NewReader(data))
+			if err != nil {
+				return err
+			}
+
+			resources, err := backup.Order(archive.Resources)
+			if err != nil {
+				return err
+			}
+
+			ops := clusterops.NewOperationsWithClient(session.Client, session.Config)
+
+			// Every call gets its own deadline, so large restores are not cut off partway
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			existing, err := ops.ExportResources(ctx, into)
+			cancel()
+			if err != nil {
+				return err
+			}
+			exists := make(map[string]bool, len(existing))
+			for _, r := range existing {
+				exists[backup.Ref(r)] = true
+			}
+
+			var conflicts []string
+			for _, r := range resources {
+				if exists[backup.Ref(r)] {
+					conflicts = append(conflicts, backup.Ref(r))
+				}
+			}
+			if len(conflicts) > 0 && onConflict == conflictFail {
+				return fmt.Errorf("%d resources already exist on cluster '%s': %s\nUse --on-conflict skip or overwrite to restore anyway",
+					len(conflicts), into, strings.Join(conflicts, ", "))
+			}
+
+			fmt.Printf("Restoring %d resources from backup of cluster '%s' (%s) into '%s'\n\n",
+				len(resources), archive.Manifest.Cluster, archive.Manifest.CreatedAt.Local().Format("2006-01-02 15:04:05"), into)
+
+			var created, updated, skipped int
+			for _, r := range resources {
+				ref := backup.Ref(r)
+				if exists[ref] && onConflict == conflictSkip {
+					fmt.Printf("  - %s skipped (already exists)\n", ref)
+					skipped++
+					continue
+				}
+
+				ctx, cancel := session.Client.WithContext(cmd.Context())
+				result, err := ops.ApplyResource(ctx, into, r, exists[ref])
+				cancel()
+				if err != nil {
+					fmt.Printf("  %s✗%s %s\n", utils.RedColor, utils.ResetColor, ref)
+					return fmt.Errorf("%v\nRestored %d of %d resources before the failure", err, created+updated, len(resources))
+				}
+
+				fmt.Printf("  %s✓%s %s %s\n", utils.GreenColor, utils.ResetColor, ref, result)
+				if result == "updated" {
+					updated++
+				} else {
+					created++
+				}
+			}
+
+			fmt.Printf("\n%s✓ Restore complete: %d created, %d updated, %d skipped%s\n", utils.BoldColor, created, updated, skipped, utils.ResetColor)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "", "Backup archive: local file or bucket://<bucket>/<key>")
+	cmd.Flags().StringVar(&into, "into", "", "Cluster to restore into")
+	cmd.Flags().StringVar(&onConflict, "on-conflict", conflictFail, "What to do with resources that already exist (skip, overwrite, fail)")
+
+	cmd.MarkFlagRequired("from")
+	cmd.MarkFlagRequired("into")
+
+	return cmd
+}
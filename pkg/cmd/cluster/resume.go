@@ -0,0 +1,70 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	clusterops "github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// NewResumeCmd creates the cluster resume command
+func NewResumeCmd() *cobra.Command {
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "resume <cluster-name>",
+		Short: "Resume a paused cluster",
+		Long: `Resume a paused cluster.
+
+The cluster's phases are streamed live until it is ready again.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ops := clusterops.NewOperationsWithClient(session.Client, session.Config)
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			details, err := ops.GetClusterDetails(ctx, name)
+			if err != nil {
+				return err
+			}
+			if details.Phase != clusterops.PhasePaused {
+				return fmt.Errorf("cluster '%s' is not paused (phase: %s)", name, details.Phase)
+			}
+
+			if _, err := ops.ResumeCluster(ctx, name); err != nil {
+				return err
+			}
+
+			watchCtx, watchCancel := context.WithTimeout(cmd.Context(), timeout)
+			defer watchCancel()
+
+			fmt.Println()
+			display := clusterops.NewProgressDisplay(os.Stdout)
+			_, err = ops.WatchCluster(watchCtx, name, display.Update)
+			display.Finish()
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("\n%s✓ Cluster '%s' is running again%s\n", utils.BoldColor, name, utils.ResetColor)
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "Maximum time to wait for the cluster to become ready")
+
+	return cmd
+}
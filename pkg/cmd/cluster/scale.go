@@ -0,0 +1,91 @@
+package cluster
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	clusterops "github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// NewScaleCmd creates the cluster scale command
+func NewScaleCmd() *cobra.Command {
+	var (
+		replicas int32
+		size     string
+		timeout  time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "scale <cluster-name>",
+		Short: "Scale a cluster",
+		Long: `Change the number of replicas or the node size of a cluster.
+
+Either or both of --replicas and --size can be given; the other dimension is
+left unchanged. A paused cluster must be resumed before it can be scaled. The
+command waits for the scaling to finish.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			if replicas < 0 {
+				return fmt.Errorf("--replicas must be at least 1")
+			}
+			if cmd.Flags().Changed("replicas") && replicas == 0 {
+				return fmt.Errorf("--replicas must be at least 1; use 'nsai cluster pause' to stop a cluster")
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ops := clusterops.NewOperationsWithClient(session.Client, session.Config)
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			details, err := ops.GetClusterDetails(ctx, name)
+			if err != nil {
+				return err
+			}
+			if details.Phase == clusterops.PhasePaused {
+				return fmt.Errorf("cluster '%s' is paused; run 'nsai cluster resume %s' first", name, name)
+			}
+			if (replicas == 0 || replicas == details.Replicas) && (size == "" || size == details.Size) {
+				fmt.Printf("cluster '%s' unchanged\n", name)
+				return nil
+			}
+
+			op, err := ops.ScaleCluster(ctx, name, replicas, size)
+			if err != nil {
+				return err
+			}
+
+			if err := waitForOperation(cmd.Context(), ops, op.Id, timeout); err != nil {
+				return err
+			}
+
+			var changes []string
+			if replicas != 0 {
+				changes = append(changes, fmt.Sprintf("%d replicas", replicas))
+			}
+			if size != "" {
+				changes = append(changes, fmt.Sprintf("size %s", size))
+			}
+			fmt.Printf("\n%s✓ Cluster '%s' scaled to %s%s\n", utils.BoldColor, name, strings.Join(changes, ", "), utils.ResetColor)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int32Var(&replicas, "replicas", 0, "Number of replicas")
+	cmd.Flags().StringVar(&size, "size", "", "Node size")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "Maximum time to wait for the scaling to finish")
+
+	cmd.MarkFlagsOneRequired("replicas", "size")
+
+	return cmd
+}
@@ -0,0 +1,139 @@
+package cluster
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/billing"
+	clusterops "github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// NewUpgradeCmd creates the cluster upgrade command
+func NewUpgradeCmd() *cobra.Command {
+	return newTierCmd("upgrade", `Upgrade a cluster to a larger tier.
+
+The price difference is shown and your credit balance is checked before you are
+asked to confirm. The command then waits for the migration to finish.`, 1)
+}
+
+// NewDowngradeCmd creates the cluster downgrade command
+func NewDowngradeCmd() *cobra.Command {
+	return newTierCmd("downgrade", `Downgrade a cluster to a smaller tier.
+
+The price difference is shown before you are asked to confirm. The command then
+waits for the migration to finish.`, -1)
+}
+
+// newTierCmd builds the upgrade or downgrade command; direction is 1 for upgrades and -1 for downgrades
+func newTierCmd(action, long string, direction int) *cobra.Command {
+	var (
+		tier    string
+		yes     bool
+		timeout time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   action + " <cluster-name>",
+		Short: strings.ToUpper(action[:1]) + action[1:] + " a cluster to a different tier",
+		Long:  long,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return changeTier(cmd, args[0], tier, direction, yes, timeout)
+		},
+	}
+
+	cmd.Flags().StringVarP(&tier, "type", "t", "", "Target cluster type ("+strings.Join(clusterops.Tiers, "/")+")")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt")
+	cmd.Flags().DurationVar(&timeout, "timeout", 60*time.Minute, "Maximum time to wait for the migration")
+
+	cmd.MarkFlagRequired("type")
+
+	return cmd
+}
+
+func changeTier(cmd *cobra.Command, name, tier string, direction int, yes bool, timeout time.Duration) error {
+	targetRank, err := clusterops.TierRank(tier)
+	if err != nil {
+		return err
+	}
+
+	session, err := auth.SessionFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	ops := clusterops.NewOperationsWithClient(session.Client, session.Config)
+	billingOps := billing.NewOperationsWithClient(session.Client, session.Config)
+
+	ctx, cancel := session.Client.WithContext(cmd.Context())
+	defer cancel()
+
+	details, err := ops.GetClusterDetails(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	currentRank, err := clusterops.TierRank(details.Type)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case targetRank == currentRank:
+		return fmt.Errorf("cluster '%s' is already of type %s", name, tier)
+	case direction > 0 && targetRank < currentRank:
+		return fmt.Errorf("%s is a smaller tier than %s; use 'nsai cluster downgrade' instead", tier, details.Type)
+	case direction < 0 && targetRank > currentRank:
+		return fmt.Errorf("%s is a larger tier than %s; use 'nsai cluster upgrade' instead", tier, details.Type)
+	}
+
+	credits, err := billingOps.CheckCredits(ctx, tier, details.CloudProvider, details.Region, name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%sTier change for cluster '%s':%s\n", utils.BoldColor, name, utils.ResetColor)
+	fmt.Printf("  Current: %s (%d credits/hour)\n", details.Type, credits.CurrentCreditsPerHour)
+	fmt.Printf("  Target:  %s (%d credits/hour)\n", tier, credits.TargetCreditsPerHour)
+	fmt.Printf("  Change:  %+d credits/hour\n", credits.TargetCreditsPerHour-credits.CurrentCreditsPerHour)
+	fmt.Printf("  Balance: %d credits\n", credits.AvailableCredits)
+
+	if clusterops.IsPaidTier(tier) && !credits.Sufficient {
+		return fmt.Errorf("insufficient credits to move cluster '%s' to %s", name, tier)
+	}
+
+	if !yes {
+		fmt.Print("\nProceed? (y/n): ")
+		reader := bufio.NewReader(os.Stdin)
+		answer, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read input: %v", err)
+		}
+		answer = strings.TrimSpace(strings.ToLower(answer))
+		if answer != "y" && answer != "yes" {
+			return fmt.Errorf("tier change cancelled")
+		}
+	}
+
+	// The confirmation prompt may have outlasted the deadline of the lookups
+	changeCtx, changeCancel := session.Client.WithContext(cmd.Context())
+	defer changeCancel()
+
+	op, err := ops.ChangeTier(changeCtx, name, tier)
+	if err != nil {
+		return err
+	}
+
+	if err := waitForOperation(cmd.Context(), ops, op.Id, timeout); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%s✓ Cluster '%s' is now of type %s%s\n", utils.BoldColor, name, tier, utils.ResetColor)
+	return nil
+}
@@ -1,6 +1,8 @@
 package create
 
 import (
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/billing"
 	"github.com/spf13/cobra"
 )
 
@@ -17,6 +19,18 @@ func NewBaseModelCmd() *cobra.Command {
 		Short: "Create a new base model",
 		Long:  `Create a new base model with specified configuration`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			if err := checkQuota(ctx, session, billing.QuotaModelDeployments); err != nil {
+				return err
+			}
+
 			// TODO: Implement base model creation logic
 			return nil
 		},
@@ -2,17 +2,18 @@ package create
 
 import (
 	"bufio"
-	"context"
 	"fmt"
 	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"text/tabwriter"
 
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/banner"
-	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/billing"
+	bucketops "github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/provider"
 	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
 	"github.com/spf13/cobra"
 )
@@ -21,6 +22,17 @@ var (
 	bucketName     string
 	bucketProvider string
 	bucketRegion   string
+	storageClass   string
+	versioning     bool
+	bucketKMSKey   string
+
+	// s3compatible location and credentials
+	bucketEndpoint    string
+	pathStyle         bool
+	credentials       string
+	credentialsSecret string
+	roleARN           string
+	stsEndpoint       string
 )
 
 // NewBucketCmd creates the bucket command
@@ -30,13 +42,28 @@ func NewBucketCmd() *cobra.Command {
 		Short: "Create a new bucket or select an existing one",
 		Long: `Create a new bucket or select an existing one for use with a cluster.
 
-If creating a new bucket, you'll be prompted for:
-- Bucket name
-- Cloud provider
+If creating a new bucket, you'll be prompted for anything not given by flags:
+- Bucket name, checked against the provider's naming rules
+- Cloud provider (defaults to the current cluster's provider)
 - Region
+- Storage class
+- Whether object versioning is enabled
+- An optional customer-managed encryption key: a KMS key ARN (aws), a Cloud KMS
+  key name (gcp), a Key Vault key URL (azure) or a key ID on the storage server
+  (s3compatible). Without one, the provider's default encryption is used.
+
+S3-compatible buckets (MinIO, Cloudflare R2, Ceph and others) are registered
+with --provider s3compatible. They also need the endpoint of the S3 API and the
+name of a platform secret holding the access key. With --credentials sts, that
+key is used to assume --role-arn through the server's STS API. The CLI never
+reads or stores the keys themselves.
 
 If selecting an existing bucket, you'll be shown a list of available buckets
 that are compatible with your cluster's cloud provider.`,
+		Example: `  # Register a bucket on a MinIO server
+  nsai create bucket training-data --provider s3compatible \
+    --endpoint https://minio.example.com:9000 --path-style \
+    --credentials-secret minio-training-data`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Print banner
@@ -44,51 +71,34 @@ that are compatible with your cluster's cloud provider.`,
 			fmt.Println("Setting up bucket...")
 			fmt.Println()
 
-			// Check if config exists
-			configPath := filepath.Join(os.Getenv("HOME"), ".nstreamconfig")
-			if _, err := os.Stat(configPath); os.IsNotExist(err) {
-				fmt.Println("No configuration found. You need to authenticate first.")
-				fmt.Println("\nPlease choose one of the following options:")
-				fmt.Println("1. Sign in to an existing account: 'nsai auth signin'")
-				fmt.Println("2. Create a new account: 'nsai auth signup'")
-				fmt.Println("\nAfter authentication, run 'nsai create bucket' again")
-				return fmt.Errorf("authentication required")
-			}
-
-			// Load config to check user credentials
-			cfg, err := config.LoadConfig()
+			session, err := auth.SessionFromContext(cmd.Context())
 			if err != nil {
-				return fmt.Errorf("failed to load config: %v", err)
-			}
-
-			// Check if user is authenticated
-			if cfg.User.AuthToken == "" {
-				fmt.Println("No authentication token found. You need to sign in first.")
-				fmt.Println("\nRun 'nsai auth signin' to authenticate")
-				fmt.Println("After authentication, run 'nsai create bucket' again")
-				return fmt.Errorf("authentication required")
+				return err
 			}
+			c, cfg := session.Client, session.Config
 
-			// Check if user exists
-			if cfg.User.Email == "" {
-				fmt.Println("No user found. You need to sign in first.")
-				fmt.Println("\nRun 'nsai auth signin' to authenticate")
-				fmt.Println("After authentication, run 'nsai create bucket' again")
-				return fmt.Errorf("authentication required")
-			}
+			// Each step gets its own deadline, so time spent at prompts does not count against it
+			ctx, cancel := c.WithContext(cmd.Context())
+			defer cancel()
 
-			// Initialize gRPC client
-			c, err := client.NewClient("localhost:8080", false, "")
+			// Check quotas up front so a new bucket is never prompted for when none can be created
+			done := make(chan bool)
+			go ShowLoading("Checking quotas", done)
+			quotas, err := billing.NewOperationsWithClient(c, cfg).GetQuotas(ctx)
+			done <- true
 			if err != nil {
-				return fmt.Errorf("failed to create client: %v", err)
+				return err
+			}
+			quotaErr := billing.CheckQuota(quotas, billing.QuotaBuckets)
+			if quotaErr != nil {
+				fmt.Printf("\n%sBucket quota reached:%s only existing buckets can be selected.\n", boldColor, resetColor)
 			}
-			defer c.Close()
-
-			ctx := context.Background()
 
 			// Get cluster details to check cloud provider
 			var clusterCloudProvider string
-			if cfg.Cluster.Name != "" {
+			if bucketProvider != "" {
+				clusterCloudProvider = bucketProvider
+			} else if cfg.Cluster.Name != "" {
 				// Create a channel for loading animation
 				done := make(chan bool)
 				go ShowLoading("Fetching cluster details", done)
@@ -110,52 +120,45 @@ that are compatible with your cluster's cloud provider.`,
 				clusterCloudProvider = detailsResp.Config.CloudProvider
 			} else {
 				// If no cluster is set, ask for cloud provider
-				fmt.Println("\nNo cluster context found. Please select a cloud provider:")
-				fmt.Println("1. AWS")
-				fmt.Println("2. GCP")
-				fmt.Println("3. Azure")
-				fmt.Print("\nEnter your choice (1-3): ")
-
-				reader := bufio.NewReader(os.Stdin)
-				choice, err := reader.ReadString('\n')
+				fmt.Println("\nNo cluster context found. Please select a cloud provider.")
+				clusterCloudProvider, err = getCloudProvider()
 				if err != nil {
-					return fmt.Errorf("failed to read input: %v", err)
+					return err
 				}
-				choice = strings.TrimSpace(choice)
-
-				switch choice {
-				case "1":
-					clusterCloudProvider = "aws"
-				case "2":
-					clusterCloudProvider = "gcp"
-				case "3":
-					clusterCloudProvider = "azure"
-				default:
-					return fmt.Errorf("invalid cloud provider choice")
+			}
+			prov, err := provider.Get(clusterCloudProvider)
+			if err != nil {
+				return err
+			}
+
+			if clusterCloudProvider != provider.S3Compatible {
+				for _, flag := range []string{"endpoint", "path-style", "credentials", "credentials-secret", "role-arn", "sts-endpoint"} {
+					if cmd.Flags().Changed(flag) {
+						return fmt.Errorf("--%s is only used with s3compatible buckets", flag)
+					}
 				}
 			}
 
 			// Check if there are existing buckets
-			done := make(chan bool)
+			ctx, cancel = c.WithContext(cmd.Context())
+			defer cancel()
+			done = make(chan bool)
 			go ShowLoading("Checking existing buckets", done)
 
 			// List buckets
-			bucketsResp, err := c.BucketClient.ListBuckets(ctx, &clusterproto.ListBucketsRequest{
-				CloudProvider: clusterCloudProvider,
-				AuthToken:     cfg.User.AuthToken,
-			})
+			buckets, err := bucketops.NewOperationsWithClient(c, cfg).ListBuckets(ctx, clusterCloudProvider, nil)
 			if err != nil {
 				done <- true
-				return fmt.Errorf("failed to get buckets: %v", err)
+				return err
 			}
 			done <- true
 
 			// If there are compatible buckets, ask if user wants to use one
-			if len(bucketsResp.Buckets) > 0 {
-				fmt.Printf("\nFound %d existing bucket(s) compatible with %s cloud provider:\n", len(bucketsResp.Buckets), clusterCloudProvider)
+			if len(buckets) > 0 {
+				fmt.Printf("\nFound %d existing bucket(s) compatible with %s cloud provider:\n", len(buckets), clusterCloudProvider)
 				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 				fmt.Fprintln(w, "ID\tName\tRegion\tProvider\tSize\tCreated At")
-				for i, bucket := range bucketsResp.Buckets {
+				for i, bucket := range buckets {
 					fmt.Fprintf(w, "%d. %s\t%s\t%s\t%s\t%s\n",
 						i+1,
 						bucket.Name,
@@ -183,11 +186,11 @@ that are compatible with your cluster's cloud provider.`,
 					}
 					choice = strings.TrimSpace(choice)
 					choiceInt, err := strconv.Atoi(choice)
-					if err != nil || choiceInt < 1 || choiceInt > len(bucketsResp.Buckets) {
+					if err != nil || choiceInt < 1 || choiceInt > len(buckets) {
 						return fmt.Errorf("invalid bucket choice")
 					}
 
-					selectedBucket := bucketsResp.Buckets[choiceInt-1]
+					selectedBucket := buckets[choiceInt-1]
 
 					// Update config with bucket details
 					cfg.Cluster.Bucket = selectedBucket.Name
@@ -207,69 +210,227 @@ that are compatible with your cluster's cloud provider.`,
 			}
 
 			// If no compatible buckets or user wants to create new, proceed with bucket creation
+			if quotaErr != nil {
+				return quotaErr
+			}
 			fmt.Println("\nCreating a new bucket...")
 
 			// Get bucket name
-			var name string
+			name := bucketName
 			if len(args) > 0 {
 				name = args[0]
-			} else {
+			}
+			reader := bufio.NewReader(os.Stdin)
+			if name == "" {
 				fmt.Print("Enter bucket name: ")
-				reader := bufio.NewReader(os.Stdin)
 				name, err = reader.ReadString('\n')
 				if err != nil {
 					return fmt.Errorf("failed to read input: %v", err)
 				}
 				name = strings.TrimSpace(name)
 			}
+			if err := prov.ValidateBucketName(name); err != nil {
+				return err
+			}
 
-			// Get region
-			region, err := getRegion(clusterCloudProvider)
+			// Get region, checking one given by flag against the region catalog
+			ctx, cancel = c.WithContext(cmd.Context())
+			defer cancel()
+			region, err := getRegion(ctx, session, clusterCloudProvider, "", bucketRegion)
 			if err != nil {
 				return err
 			}
 
+			// Get storage class
+			class := storageClass
+			if class == "" {
+				class, err = getStorageClass(reader, prov)
+				if err != nil {
+					return err
+				}
+			}
+			if err := provider.ValidateStorageClass(prov, class); err != nil {
+				return err
+			}
+
+			// Ask about versioning unless the flag was given
+			enableVersioning := versioning
+			if !cmd.Flags().Changed("versioning") {
+				fmt.Print("\nEnable object versioning? (y/N): ")
+				answer, err := reader.ReadString('\n')
+				if err != nil {
+					return fmt.Errorf("failed to read input: %v", err)
+				}
+				answer = strings.TrimSpace(strings.ToLower(answer))
+				enableVersioning = answer == "y" || answer == "yes"
+			}
+
+			// Ask for a customer-managed key unless the flag was given
+			kmsKey := bucketKMSKey
+			if !cmd.Flags().Changed("kms-key") {
+				fmt.Print("\nEnter a customer-managed key (press Enter for provider-managed encryption): ")
+				kmsKey, err = reader.ReadString('\n')
+				if err != nil {
+					return fmt.Errorf("failed to read input: %v", err)
+				}
+				kmsKey = strings.TrimSpace(kmsKey)
+			}
+			if kmsKey != "" {
+				if err := prov.ValidateKMSKey(kmsKey); err != nil {
+					return err
+				}
+			}
+
+			// S3-compatible buckets need to know where the server is and how to sign in to it
+			var s3Compatible *clusterproto.S3CompatibleConfig
+			if clusterCloudProvider == provider.S3Compatible {
+				s3Compatible, err = getS3CompatibleConfig(reader)
+				if err != nil {
+					return err
+				}
+			}
+
 			// Create bucket
+			ctx, cancel = c.WithContext(cmd.Context())
+			defer cancel()
 			done = make(chan bool)
 			go ShowLoading("Creating bucket", done)
 
-			// Create bucket using gRPC
-			createResp, err := c.ClusterClient.CreateCluster(ctx, &clusterproto.CreateClusterRequest{
+			created, err := bucketops.NewOperationsWithClient(c, cfg).CreateBucket(ctx, &clusterproto.CreateBucketRequest{
 				Name:          name,
-				Type:          "basic",
 				CloudProvider: clusterCloudProvider,
 				Region:        region,
-				Bucket:        name,
-				Role:          "",
+				StorageClass:  class,
+				Versioning:    enableVersioning,
+				S3Compatible:  s3Compatible,
+				KmsKey:        kmsKey,
 			})
+			done <- true
 			if err != nil {
-				done <- true
-				return fmt.Errorf("failed to create bucket: %v", err)
-			}
-			if createResp.Error != "" {
-				done <- true
-				return fmt.Errorf("failed to create bucket: %s", createResp.Error)
+				return err
 			}
-			done <- true
 
 			// Update config with bucket details
-			cfg.Cluster.Bucket = name
+			cfg.Cluster.Bucket = created.Name
 			if err := config.SaveConfig(cfg); err != nil {
 				return fmt.Errorf("failed to save config: %v", err)
 			}
 
 			fmt.Printf("\n%s✓ Successfully created bucket%s\n", boldColor, resetColor)
 			fmt.Printf("\n%sBucket Details:%s\n", boldColor, resetColor)
-			fmt.Printf("  Name: %s\n", createResp.Config.Bucket)
-			fmt.Printf("  Region: %s\n", createResp.Config.Region)
-			fmt.Printf("  Provider: %s\n", createResp.Config.CloudProvider)
+			fmt.Printf("  Name: %s\n", created.Name)
+			fmt.Printf("  Region: %s\n", created.Region)
+			fmt.Printf("  Provider: %s\n", created.Provider)
+			fmt.Printf("  Storage Class: %s\n", created.StorageClass)
+			fmt.Printf("  Versioning: %s\n", enabledLabel(created.Versioning))
+			if created.KmsKey != "" {
+				fmt.Printf("  KMS Key: %s\n", created.KmsKey)
+				fmt.Printf("\nGrant NStream and the storage service access to the key with 'nsai bucket access-template --cloud %s --bucket %s --kms-key %s --format key-policy'.\n",
+					created.Provider, created.Name, created.KmsKey)
+			}
+			if created.S3Compatible != nil {
+				fmt.Printf("  Endpoint: %s\n", created.S3Compatible.Endpoint)
+				fmt.Printf("  Credentials: %s (secret '%s')\n", created.S3Compatible.Credentials, created.S3Compatible.CredentialsSecret)
+			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVarP(&bucketName, "name", "n", "", "Bucket name (optional, will prompt if not provided)")
-	cmd.Flags().StringVarP(&bucketProvider, "provider", "p", "", "Cloud provider (aws/gcp/azure)")
+	cmd.Flags().StringVarP(&bucketProvider, "provider", "p", "", "Cloud provider ("+strings.Join(provider.Names(), ", ")+")")
 	cmd.Flags().StringVarP(&bucketRegion, "region", "r", "", "Region for the bucket")
+	cmd.Flags().StringVar(&storageClass, "storage-class", "", "Storage class for the bucket (e.g. STANDARD on aws/gcp, Hot on azure)")
+	cmd.Flags().BoolVar(&versioning, "versioning", false, "Keep previous versions of overwritten and deleted objects")
+	cmd.Flags().StringVar(&bucketKMSKey, "kms-key", "", "Customer-managed key to encrypt objects with [default: prompt]")
+	cmd.Flags().StringVar(&bucketEndpoint, "endpoint", "", "URL of the S3 API for s3compatible buckets, e.g. https://minio.example.com:9000")
+	cmd.Flags().BoolVar(&pathStyle, "path-style", false, "Address s3compatible buckets by path instead of by virtual host")
+	cmd.Flags().StringVar(&credentials, "credentials", provider.CredentialsStatic, "How s3compatible buckets are accessed (static/sts)")
+	cmd.Flags().StringVar(&credentialsSecret, "credentials-secret", "", "Platform secret holding the access key of s3compatible buckets")
+	cmd.Flags().StringVar(&roleARN, "role-arn", "", "Role to assume with sts credentials")
+	cmd.Flags().StringVar(&stsEndpoint, "sts-endpoint", "", "URL of the STS API with sts credentials (defaults to the endpoint)")
 
 	return cmd
 }
+
+// getStorageClass prompts for one of the provider's storage classes, defaulting to the first
+func getStorageClass(reader *bufio.Reader, prov provider.Provider) (string, error) {
+	classes := prov.StorageClasses()
+	if len(classes) == 0 {
+		return "", fmt.Errorf("no storage classes available for provider %s", prov.Name())
+	}
+
+	fmt.Printf("\nAvailable storage classes for %s:\n", prov.Name())
+	for i, class := range classes {
+		fmt.Printf("%d. %s\n", i+1, class)
+	}
+	fmt.Printf("\nSelect storage class (1-%d, press Enter for %s): ", len(classes), classes[0])
+
+	choice, err := reader.ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("failed to read input: %v", err)
+	}
+	choice = strings.TrimSpace(choice)
+	if choice == "" {
+		return classes[0], nil
+	}
+
+	index, err := strconv.Atoi(choice)
+	if err != nil || index < 1 || index > len(classes) {
+		return "", fmt.Errorf("invalid storage class selection")
+	}
+	return classes[index-1], nil
+}
+
+// getS3CompatibleConfig prompts for the endpoint and credentials of an s3compatible bucket
+// that were not given by flags
+func getS3CompatibleConfig(reader *bufio.Reader) (*clusterproto.S3CompatibleConfig, error) {
+	cfg := &clusterproto.S3CompatibleConfig{
+		Endpoint:          bucketEndpoint,
+		PathStyle:         pathStyle,
+		Credentials:       credentials,
+		CredentialsSecret: credentialsSecret,
+		RoleArn:           roleARN,
+		StsEndpoint:       stsEndpoint,
+	}
+
+	prompt := func(label string, value *string) error {
+		if *value != "" {
+			return nil
+		}
+		fmt.Printf("Enter %s: ", label)
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read input: %v", err)
+		}
+		*value = strings.TrimSpace(input)
+		return nil
+	}
+
+	if err := prompt("endpoint URL", &cfg.Endpoint); err != nil {
+		return nil, err
+	}
+	if err := prompt("name of the secret holding the access key", &cfg.CredentialsSecret); err != nil {
+		return nil, err
+	}
+	if cfg.Credentials == provider.CredentialsSTS {
+		if err := prompt("ARN of the role to assume", &cfg.RoleArn); err != nil {
+			return nil, err
+		}
+	}
+	if err := provider.ValidateS3CompatibleConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	if provider.IsInsecureEndpoint(cfg.Endpoint) {
+		fmt.Printf("\n%sWarning:%s %s is not https. Objects and request signatures will be sent unencrypted.\n", boldColor, resetColor, cfg.Endpoint)
+	}
+	return cfg, nil
+}
+
+// enabledLabel renders a boolean setting for display
+func enabledLabel(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
@@ -0,0 +1,146 @@
+package create
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/billing"
+	bucketops "github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+	"github.com/spf13/cobra"
+)
+
+// cloneCluster creates a cluster from the settings of an existing one, applying flag overrides
+func cloneCluster(cmd *cobra.Command, name, source string) error {
+	if cmd.Flags().Changed("cloud") {
+		return fmt.Errorf("--cloud cannot be used with --from; a clone stays on the source cluster's cloud provider")
+	}
+
+	fmt.Printf("Cloning cluster '%s' into '%s'...\n\n", source, name)
+
+	session, err := auth.SessionFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+	c, cfg := session.Client, session.Config
+
+	ctx, cancel := c.WithContext(cmd.Context())
+	defer cancel()
+
+	if err := checkQuota(ctx, session, billing.QuotaClusters); err != nil {
+		return err
+	}
+
+	ops := cluster.NewOperationsWithClient(c, cfg)
+
+	done := make(chan bool)
+	go ShowLoading("Fetching source cluster", done)
+	src, err := ops.GetClusterDetails(ctx, source)
+	done <- true
+	if err != nil {
+		return err
+	}
+
+	// Only flags given explicitly override the source cluster's settings
+	req := &clusterproto.CloneClusterRequest{
+		SourceClusterName: source,
+		Name:              name,
+	}
+	req.IncludeResources, _ = cmd.Flags().GetBool("with-resources")
+	if cmd.Flags().Changed("type") {
+		req.Type, _ = cmd.Flags().GetString("type")
+		if _, err := cluster.TierRank(req.Type); err != nil {
+			return err
+		}
+	}
+	if cmd.Flags().Changed("region") {
+		req.Region, _ = cmd.Flags().GetString("region")
+	}
+	if cmd.Flags().Changed("bucket") {
+		req.Bucket, _ = cmd.Flags().GetString("bucket")
+	}
+	if cmd.Flags().Changed("role") {
+		req.Role, _ = cmd.Flags().GetString("role")
+	}
+
+	clusterType := firstNonEmpty(req.Type, src.Type)
+	region := firstNonEmpty(req.Region, src.Region)
+	bucket := firstNonEmpty(req.Bucket, src.Bucket)
+	role := firstNonEmpty(req.Role, src.Role)
+
+	// A new region or tier has to be offered by the region catalog
+	if req.Region != "" || req.Type != "" {
+		if _, err := getRegion(ctx, session, src.CloudProvider, clusterType, region); err != nil {
+			return err
+		}
+	}
+
+	if cluster.IsPaidTier(clusterType) {
+		done := make(chan bool)
+		go ShowLoading("Checking credits", done)
+		_, err := billing.NewOperationsWithClient(c, cfg).RequireCredits(ctx, clusterType, src.CloudProvider, region, "")
+		done <- true
+		if err != nil {
+			return err
+		}
+	}
+
+	// A different bucket or role has not been verified for this cluster yet
+	if req.Bucket != "" || req.Role != "" {
+		done := make(chan bool)
+		go ShowLoading("Verifying bucket access", done)
+		accessResp, err := bucketops.NewOperationsWithClient(c, cfg).VerifyAccess(ctx, src.CloudProvider, bucket, role)
+		done <- true
+		if err != nil {
+			return err
+		}
+		if !accessResp.HasAccess {
+			fmt.Println()
+			bucketops.WriteAccessChecks(os.Stdout, src.CloudProvider, bucket, role, accessResp)
+			return fmt.Errorf("bucket access verification failed")
+		}
+	}
+
+	done = make(chan bool)
+	go ShowLoading("Cloning your NStream AI cluster", done)
+	cloneResp, err := ops.CloneCluster(ctx, req)
+	done <- true
+	if err != nil {
+		return err
+	}
+
+	if err := saveClusterContext(cfg, cloneResp.Config); err != nil {
+		return err
+	}
+
+	if err := followProvisioning(cmd, c, cfg, cloneResp.Config); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%sCluster Details:%s\n", utils.BoldColor, utils.ResetColor)
+	fmt.Printf("  Name: %s\n", cloneResp.Config.Name)
+	fmt.Printf("  Cloned From: %s\n", source)
+	fmt.Printf("  Type: %s\n", clusterType)
+	fmt.Printf("  Cloud Provider: %s\n", src.CloudProvider)
+	fmt.Printf("  Region: %s\n", region)
+	fmt.Printf("  Bucket: %s\n", bucket)
+	fmt.Printf("  Bucket Access %s: %s\n", getRoleType(src.CloudProvider), role)
+	if req.IncludeResources {
+		fmt.Printf("  Copied Resources: %d\n", cloneResp.CopiedResources)
+	}
+	fmt.Printf("\n%sYou can now use this cluster with 'nsai use cluster %s'%s\n", utils.BoldColor, name, utils.ResetColor)
+
+	return nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
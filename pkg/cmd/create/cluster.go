@@ -1,16 +1,23 @@
 package create
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
+	"time"
 
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/banner"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/billing"
+	bucketops "github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/provider"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
-	authproto "github.com/nstreama-ai/nstream-ai-cli/proto/auth"
 	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
 	"github.com/spf13/cobra"
 )
@@ -20,90 +27,56 @@ func NewClusterCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "cluster [cluster-name]",
 		Short: "Create a new NStream AI cluster",
-		Long:  `Create a new NStream AI cluster with specified configuration`,
-		Args:  cobra.ExactArgs(1),
+		Long: `Create a new NStream AI cluster with specified configuration.
+
+With --from, the new cluster copies the type, cloud, region, bucket and bucket
+access settings of an existing cluster instead of running the interactive
+wizard. --type, --region, --bucket and --role override the copied settings, and
+--with-resources also copies its resource definitions.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return createCluster(args[0])
+			return createCluster(cmd, args[0])
 		},
 	}
 
 	cmd.Flags().StringP("type", "t", "basic", "Cluster type (basic/standard/enterprise)")
-	cmd.Flags().StringP("cloud", "c", "gcp", "Cloud provider (aws/gcp/azure)")
+	cmd.Flags().StringP("cloud", "c", "gcp", "Cloud provider ("+strings.Join(provider.Names(), ", ")+")")
 	cmd.Flags().StringP("region", "r", "", "Region for the cluster")
 	cmd.Flags().StringP("bucket", "b", "", "Bucket name for storage")
 	cmd.Flags().StringP("role", "p", "", "Role/principal to assume for bucket access")
+	cmd.Flags().String("kms-key", "", "Customer-managed key to encrypt a new bucket with (KMS key ARN, Cloud KMS key name or Key Vault key URL)")
+	cmd.Flags().BoolP("wait", "w", false, "Wait for the cluster to finish provisioning, showing each phase")
+	cmd.Flags().Duration("timeout", 30*time.Minute, "Maximum time to wait when --wait is set")
+	cmd.Flags().String("from", "", "Existing cluster to clone settings from instead of running the wizard")
+	cmd.Flags().Bool("with-resources", false, "With --from, also copy the source cluster's resource definitions")
 
 	return cmd
 }
 
-func createCluster(name string) error {
+func createCluster(cmd *cobra.Command, name string) error {
 	// Print banner
 	banner.PrintBanner()
-	fmt.Println("Creating a new NStream AI cluster...")
-	fmt.Println()
 
-	// Check if config exists
-	if !config.ConfigExists() {
-		fmt.Println("No configuration found. Please authenticate first:")
-		fmt.Println("1. Sign in: 'nsai auth signin'")
-		fmt.Println("2. Sign up: 'nsai auth signup'")
-		return fmt.Errorf("authentication required")
-	}
-
-	// Load config to check user credentials
-	cfg, err := config.LoadConfig()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %v", err)
+	if source, _ := cmd.Flags().GetString("from"); source != "" {
+		return cloneCluster(cmd, name, source)
 	}
 
-	// Check if user is authenticated
-	if cfg.User.AuthToken == "" {
-		fmt.Println("No authentication token found. Please authenticate first:")
-		fmt.Println("1. Sign in: 'nsai auth signin'")
-		fmt.Println("2. Sign up: 'nsai auth signup'")
-		return fmt.Errorf("authentication required")
-	}
+	fmt.Println("Creating a new NStream AI cluster...")
+	fmt.Println()
 
-	// Create gRPC client
-	c, err := client.NewClient("", true, "")
+	session, err := auth.SessionFromContext(cmd.Context())
 	if err != nil {
-		return fmt.Errorf("failed to create client: %v", err)
+		return err
 	}
-	defer c.Close()
+	c, cfg := session.Client, session.Config
 
-	// Create context
-	ctx, cancel := c.WithContext(context.Background())
+	// Each step gets its own deadline, so time spent at prompts does not count against it
+	ctx, cancel := c.WithContext(cmd.Context())
 	defer cancel()
 
-	// Validate user
-	validateResp, err := c.AuthClient.ValidateUser(ctx, &authproto.ValidateUserRequest{
-		Email: cfg.User.Email,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to validate user: %v", err)
-	}
-
-	if !validateResp.Valid {
-		fmt.Println("User validation failed. Please authenticate first:")
-		fmt.Println("1. Sign in: 'nsai auth signin'")
-		fmt.Println("2. Sign up: 'nsai auth signup'")
-		return fmt.Errorf("authentication required")
-	}
-
-	// Validate token
-	tokenResp, err := c.AuthClient.ValidateToken(ctx, &authproto.ValidateTokenRequest{
-		Token: cfg.User.AuthToken,
-	})
-	if err != nil {
-		return fmt.Errorf("error validating token: %v", err)
-	}
-
-	if !tokenResp.Valid {
-		fmt.Printf("Authentication token is invalid: %s\n", tokenResp.Error)
-		fmt.Println("\nPlease authenticate first:")
-		fmt.Println("1. Sign in: 'nsai auth signin'")
-		fmt.Println("2. Sign up: 'nsai auth signup'")
-		return fmt.Errorf("authentication required")
+	// Fail before any prompt if the organization is at its cluster limit
+	if err := checkQuota(ctx, session, billing.QuotaClusters); err != nil {
+		return err
 	}
 
 	// Get cluster type
@@ -118,36 +91,54 @@ func createCluster(name string) error {
 		return err
 	}
 
-	// Get region
-	region, err := getRegion(cloudProvider)
+	// Get region, checking the one given by flag against the regions offering the cluster type
+	regionFlag, _ := cmd.Flags().GetString("region")
+	ctx, cancel = c.WithContext(cmd.Context())
+	defer cancel()
+	region, err := getRegion(ctx, session, cloudProvider, clusterType, regionFlag)
 	if err != nil {
 		return err
 	}
 
+	ctx, cancel = c.WithContext(cmd.Context())
+	defer cancel()
+
+	// Paid tiers need enough credits before anything is provisioned
+	if cluster.IsPaidTier(clusterType) {
+		done := make(chan bool)
+		go ShowLoading("Checking credits", done)
+		_, err := billing.NewOperationsWithClient(c, cfg).RequireCredits(ctx, clusterType, cloudProvider, region, "")
+		done <- true
+		if err != nil {
+			return err
+		}
+	}
+
 	// Check for existing buckets with matching cloud provider
 	done := make(chan bool)
 	go ShowLoading("Checking existing buckets", done)
 
 	// Get buckets
-	bucketsResp, err := c.BucketClient.ListBuckets(ctx, &clusterproto.ListBucketsRequest{
-		CloudProvider: cloudProvider,
-		AuthToken:     cfg.User.AuthToken,
-	})
+	buckets, err := bucketops.NewOperationsWithClient(c, cfg).ListBuckets(ctx, cloudProvider, nil)
 	if err != nil {
 		done <- true
-		return fmt.Errorf("failed to get buckets: %v", err)
+		return err
 	}
 	done <- true
 
 	var bucket string
 	var userRole string
 
+	// s3compatible buckets need an endpoint and credentials, so they are registered on their own first
+	s3Compatible := cloudProvider == provider.S3Compatible
+	var selected *clusterproto.Bucket
+
 	// If there are compatible buckets, ask if user wants to use one
-	if len(bucketsResp.Buckets) > 0 {
-		fmt.Printf("\nFound %d existing bucket(s) compatible with %s cloud provider:\n", len(bucketsResp.Buckets), cloudProvider)
+	if len(buckets) > 0 {
+		fmt.Printf("\nFound %d existing bucket(s) compatible with %s cloud provider:\n", len(buckets), cloudProvider)
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 		fmt.Fprintln(w, "ID\tName\tRegion\tProvider\tSize\tCreated At")
-		for i, b := range bucketsResp.Buckets {
+		for i, b := range buckets {
 			fmt.Fprintf(w, "%d. %s\t%s\t%s\t%s\t%s\n",
 				i+1,
 				b.Name,
@@ -168,81 +159,95 @@ func createCluster(name string) error {
 			var choice int
 			fmt.Scanln(&choice)
 
-			if choice < 1 || choice > len(bucketsResp.Buckets) {
+			if choice < 1 || choice > len(buckets) {
 				return fmt.Errorf("invalid bucket choice")
 			}
 
-			bucket = bucketsResp.Buckets[choice-1].Name
+			selected = buckets[choice-1]
+			bucket = selected.Name
+		} else if s3Compatible {
+			return fmt.Errorf("s3compatible clusters need an existing bucket. Register one with 'nsai create bucket --provider s3compatible' first")
 		} else {
 			// Get new bucket name
 			fmt.Print("\nEnter your bucket name: ")
 			fmt.Scanln(&bucket)
 		}
+	} else if s3Compatible {
+		return fmt.Errorf("s3compatible clusters need an existing bucket. Register one with 'nsai create bucket --provider s3compatible' first")
 	} else {
 		// Get new bucket name
 		fmt.Print("\nEnter your bucket name: ")
 		fmt.Scanln(&bucket)
 	}
 
-	// Get role/principal for bucket access
-	fmt.Printf("\nEnter the name for your bucket access %s: ", getRoleType(cloudProvider))
-	fmt.Scanln(&userRole)
-
-	// Get NStream service role
-	done = make(chan bool)
-	go ShowLoading("Fetching NStream service role", done)
-	serviceRole, err := DummyGetServiceRole(cloudProvider)
-	if err != nil {
-		done <- true
-		return fmt.Errorf("failed to get service role: %v", err)
+	// Existing buckets keep their key; a new one is encrypted with the given key, if any
+	var kmsKey string
+	if selected != nil {
+		kmsKey = selected.KmsKey
+	} else {
+		kmsKey, _ = cmd.Flags().GetString("kms-key")
+		if !cmd.Flags().Changed("kms-key") {
+			fmt.Print("\nEnter a customer-managed key for the bucket (press Enter for provider-managed encryption): ")
+			fmt.Scanln(&kmsKey)
+		}
+		if kmsKey != "" {
+			prov, err := provider.Get(cloudProvider)
+			if err != nil {
+				return err
+			}
+			if err := prov.ValidateKMSKey(kmsKey); err != nil {
+				return err
+			}
+		}
 	}
-	done <- true
 
-	// Show cloud-specific setup instructions
-	fmt.Println("\nPlease follow these steps to set up bucket access:")
-	fmt.Println(GetCloudSetupInstructions(cloudProvider, serviceRole, userRole))
-	fmt.Print("\nPress Enter when you have completed the setup...")
-	fmt.Scanln()
+	var serviceRole string
+	if s3Compatible {
+		// NStream uses the bucket's credentials secret, assuming its role when it has one
+		userRole = selected.S3Compatible.GetRoleArn()
+		policy, err := provider.RenderAccessTemplate("json-policy", provider.AccessParams{
+			Cloud:  cloudProvider,
+			Bucket: bucket,
+			Role:   userRole,
+			KMSKey: kmsKey,
+		})
+		if err != nil {
+			return err
+		}
 
-	// Verify bucket access
-	done = make(chan bool)
-	go ShowLoading("Verifying bucket access", done)
-	accessResp, err := c.BucketClient.VerifyBucketAccess(ctx, &clusterproto.VerifyBucketAccessRequest{
-		CloudProvider: cloudProvider,
-		Bucket:        bucket,
-		Role:          userRole,
-		AuthToken:     cfg.User.AuthToken,
-	})
-	if err != nil {
-		done <- true
-		return fmt.Errorf("failed to verify bucket access: %v", err)
+		fmt.Printf("\nAttach the following policy to the user or role of the credentials secret '%s' on %s:\n\n",
+			selected.S3Compatible.GetCredentialsSecret(), selected.S3Compatible.GetEndpoint())
+		fmt.Println(policy)
+		fmt.Print("\nPress Enter when you have completed the setup...")
+		fmt.Scanln()
+	} else if userRole, serviceRole, err = setupBucketAccess(cmd.Context(), session, cloudProvider, bucket, kmsKey); err != nil {
+		return err
 	}
-	if !accessResp.HasAccess {
-		done <- true
-		return fmt.Errorf("bucket access verification failed: %s", accessResp.Error)
+
+	// Verify bucket access, letting the user fix failed checks and retry
+	if err := verifyBucketAccess(cmd, session, cloudProvider, bucket, userRole); err != nil {
+		return err
 	}
-	done <- true
+
+	// Setup and retries may outlast the deadline of the bucket lookup
+	ctx, cancel = c.WithContext(cmd.Context())
+	defer cancel()
 
 	// Check resource readiness
 	done = make(chan bool)
 	go ShowLoading("Checking resource readiness", done)
-	readyResp, err := c.BucketClient.CheckResourceReadiness(ctx, &clusterproto.CheckResourceReadinessRequest{
-		CloudProvider: cloudProvider,
-		Bucket:        bucket,
-		Role:          userRole,
-		AuthToken:     cfg.User.AuthToken,
-	})
+	readyResp, err := bucketops.NewOperationsWithClient(c, cfg).CheckReadiness(ctx, cloudProvider, bucket, userRole, kmsKey)
+	done <- true
 	if err != nil {
-		done <- true
-		return fmt.Errorf("failed to check resource readiness: %v", err)
+		return err
 	}
 	if !readyResp.Ready {
-		done <- true
+		fmt.Println()
+		bucketops.WriteReadinessChecks(os.Stdout, cloudProvider, bucket, userRole, readyResp)
 		return fmt.Errorf("resources not ready: %s", readyResp.Error)
 	}
-	done <- true
 
-	// Create cluster
+	// Start cluster provisioning
 	done = make(chan bool)
 	go ShowLoading("Creating your NStream AI cluster", done)
 
@@ -253,6 +258,7 @@ func createCluster(name string) error {
 		Region:        region,
 		Bucket:        bucket,
 		Role:          userRole,
+		KmsKey:        kmsKey,
 		AuthToken:     cfg.User.AuthToken,
 	})
 	if err != nil {
@@ -265,33 +271,145 @@ func createCluster(name string) error {
 	}
 	done <- true
 
-	// Update config with cluster details
-	cfg.Cluster = config.ClusterConfig{
-		Name:          createResp.Config.Name,
-		Region:        createResp.Config.Region,
-		CloudProvider: createResp.Config.CloudProvider,
-		Bucket:        createResp.Config.Bucket,
-		Role:          createResp.Config.Role,
-		ClusterToken:  createResp.Config.ClusterToken,
+	if err := saveClusterContext(cfg, createResp.Config); err != nil {
+		return err
 	}
-	if err := config.SaveConfig(cfg); err != nil {
-		return fmt.Errorf("failed to save config: %v", err)
+
+	if err := followProvisioning(cmd, c, cfg, createResp.Config); err != nil {
+		return err
 	}
 
-	fmt.Printf("\n%s✓ Successfully created cluster!%s\n", utils.BoldColor, utils.ResetColor)
 	fmt.Printf("\n%sCluster Details:%s\n", utils.BoldColor, utils.ResetColor)
 	fmt.Printf("  Name: %s\n", createResp.Config.Name)
 	fmt.Printf("  Type: %s\n", clusterType)
 	fmt.Printf("  Cloud Provider: %s\n", cloudProvider)
 	fmt.Printf("  Region: %s\n", region)
 	fmt.Printf("  Bucket: %s\n", bucket)
-	fmt.Printf("  Bucket Access %s: %s\n", getRoleType(cloudProvider), userRole)
-	fmt.Printf("  NStream Service Role: %s\n", serviceRole)
+	if kmsKey != "" {
+		fmt.Printf("  KMS Key: %s\n", kmsKey)
+	}
+	if userRole != "" {
+		fmt.Printf("  Bucket Access %s: %s\n", getRoleType(cloudProvider), userRole)
+	}
+	if s3Compatible {
+		fmt.Printf("  Endpoint: %s\n", selected.S3Compatible.GetEndpoint())
+	} else {
+		fmt.Printf("  NStream Service Role: %s\n", serviceRole)
+	}
 	fmt.Printf("\n%sYou can now use this cluster with 'nsai use cluster %s'%s\n", utils.BoldColor, name, utils.ResetColor)
 
 	return nil
 }
 
+// setupBucketAccess prompts for the role NStream uses to access the bucket and shows the
+// template that sets it up, along with the grants on the bucket's customer-managed key when
+// kmsKey is set. It returns the role and the NStream service role it trusts.
+func setupBucketAccess(ctx context.Context, session *auth.Session, cloudProvider, bucket, kmsKey string) (string, string, error) {
+	prov, err := provider.Get(cloudProvider)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Get role/principal for bucket access
+	var userRole string
+	fmt.Printf("\nEnter the name for your bucket access %s (default: %s): ", prov.RoleType(), prov.DefaultRole())
+	fmt.Scanln(&userRole)
+	if userRole == "" {
+		userRole = prov.DefaultRole()
+	}
+	if err := prov.ValidateRole(userRole); err != nil {
+		return "", "", err
+	}
+
+	// The role prompt does not count against the deadline of the lookup
+	ctx, cancel := session.Client.WithContext(ctx)
+	defer cancel()
+
+	// Get NStream service role
+	done := make(chan bool)
+	go ShowLoading("Fetching NStream service role", done)
+	role, err := bucketops.NewOperationsWithClient(session.Client, session.Config).GetServiceRole(ctx, cloudProvider)
+	done <- true
+	if err != nil {
+		return "", "", err
+	}
+
+	// Show the cloud-specific template that sets up bucket access
+	params := provider.AccessParams{
+		Cloud:       cloudProvider,
+		Bucket:      bucket,
+		Role:        userRole,
+		ServiceRole: role.ServiceRole,
+		ExternalID:  role.ExternalId,
+		Issuer:      role.Issuer,
+		KMSKey:      kmsKey,
+	}
+	format := provider.DefaultAccessFormat(prov)
+	template, err := provider.RenderAccessTemplate(format, params)
+	if err != nil {
+		return "", "", err
+	}
+
+	fmt.Printf("\nApply the following %s to set up bucket access:\n\n", format)
+	fmt.Println(template)
+
+	if kmsKey != "" {
+		keyPolicy, err := provider.RenderAccessTemplate("key-policy", params)
+		if err != nil {
+			return "", "", err
+		}
+		fmt.Printf("Grant access to the customer-managed key '%s' with:\n\n", kmsKey)
+		fmt.Println(keyPolicy)
+	}
+	fmt.Printf("Other formats are available with 'nsai bucket access-template --cloud %s --bucket %s --role %s --format <format>'.\n", cloudProvider, bucket, userRole)
+	fmt.Print("\nPress Enter when you have completed the setup...")
+	fmt.Scanln()
+
+	return userRole, role.ServiceRole, nil
+}
+
+// saveClusterContext makes a newly created cluster the current cluster context
+func saveClusterContext(cfg *config.Config, created *clusterproto.ClusterConfig) error {
+	cfg.Cluster = config.ClusterConfig{
+		Name:          created.Name,
+		Region:        created.Region,
+		CloudProvider: created.CloudProvider,
+		Bucket:        created.Bucket,
+		Role:          created.Role,
+		ClusterToken:  created.ClusterToken,
+	}
+	if err := config.SaveConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save config: %v", err)
+	}
+	return nil
+}
+
+// followProvisioning streams provisioning phases when --wait is set, or explains how to follow them
+func followProvisioning(cmd *cobra.Command, c *client.Client, cfg *config.Config, created *clusterproto.ClusterConfig) error {
+	wait, _ := cmd.Flags().GetBool("wait")
+	if !wait {
+		fmt.Printf("\n%s✓ Cluster creation started (phase: %s)%s\n", utils.BoldColor, cluster.PhaseLabel(created.Phase), utils.ResetColor)
+		fmt.Printf("\nFollow provisioning with 'nsai get cluster -n %s --watch'\n", created.Name)
+		return nil
+	}
+
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	watchCtx, watchCancel := context.WithTimeout(cmd.Context(), timeout)
+	defer watchCancel()
+
+	fmt.Println()
+	display := cluster.NewProgressDisplay(os.Stdout)
+	ops := cluster.NewOperationsWithClient(c, cfg)
+	_, err := ops.WatchCluster(watchCtx, created.Name, display.Update)
+	display.Finish()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%s✓ Successfully created cluster!%s\n", utils.BoldColor, utils.ResetColor)
+	return nil
+}
+
 func getClusterType() (string, error) {
 	fmt.Println("\nAvailable cluster types:")
 	fmt.Println("1. Basic (Free)")
@@ -314,38 +432,84 @@ func getClusterType() (string, error) {
 	}
 }
 
+// getCloudProvider prompts for one of the registered cloud providers
 func getCloudProvider() (string, error) {
+	providers := provider.All()
+
 	fmt.Println("\nAvailable cloud providers:")
-	fmt.Println("1. AWS")
-	fmt.Println("2. GCP")
-	fmt.Println("3. Azure")
-	fmt.Print("\nSelect cloud provider (1-3): ")
+	for i, prov := range providers {
+		fmt.Printf("%d. %s\n", i+1, prov.DisplayName())
+	}
+	fmt.Printf("\nSelect cloud provider (1-%d): ", len(providers))
 
 	var choice int
 	fmt.Scanln(&choice)
 
-	switch choice {
-	case 1:
-		return "aws", nil
-	case 2:
-		return "gcp", nil
-	case 3:
-		return "azure", nil
-	default:
+	if choice < 1 || choice > len(providers) {
 		return "", fmt.Errorf("invalid cloud provider selection")
 	}
+
+	return providers[choice-1].Name(), nil
 }
 
-func getRegion(provider string) (string, error) {
-	regions := GetCloudRegions(provider)
+// getRegion checks a region given by flag against the region catalog, or prompts for one of
+// the provider's regions. With a cluster type, only regions offering that tier are accepted.
+func getRegion(ctx context.Context, session *auth.Session, cloudProvider, clusterType, region string) (string, error) {
+	prov, err := provider.Get(cloudProvider)
+	if err != nil {
+		return "", err
+	}
+
+	// Providers outside the catalog, such as S3-compatible servers, name their own regions
+	if defaultRegion := prov.DefaultRegion(); defaultRegion != "" {
+		if region != "" {
+			return region, nil
+		}
+		fmt.Printf("\nEnter region (press Enter for %s): ", defaultRegion)
+		fmt.Scanln(&region)
+		if region == "" {
+			return defaultRegion, nil
+		}
+		return region, nil
+	}
+
+	done := make(chan bool)
+	go ShowLoading("Fetching regions", done)
+	catalog, err := cluster.NewOperationsWithClient(session.Client, session.Config).RegionCatalog(ctx)
+	done <- true
+	if err != nil {
+		return "", err
+	}
+	if catalog.Stale {
+		fmt.Printf("\n%sWarning:%s the mothership could not be reached; using regions cached on %s.\n",
+			utils.BoldColor, utils.ResetColor, catalog.FetchedAt.Local().Format("2006-01-02 15:04"))
+	}
+
+	if region != "" {
+		if err := catalog.Validate(cloudProvider, clusterType, region); err != nil {
+			return "", err
+		}
+		return region, nil
+	}
+
+	regions := catalog.Available(cloudProvider, clusterType)
 	if len(regions) == 0 {
-		return "", fmt.Errorf("no regions available for provider %s", provider)
+		if clusterType != "" {
+			return "", fmt.Errorf("no %s regions available for %s clusters", cloudProvider, clusterType)
+		}
+		return "", fmt.Errorf("no regions available for provider %s", cloudProvider)
 	}
 
-	fmt.Printf("\nAvailable regions for %s:\n", provider)
-	for i, region := range regions {
-		fmt.Printf("%d. %s\n", i+1, region)
+	fmt.Printf("\nAvailable regions for %s:\n", cloudProvider)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for i, r := range regions {
+		if clusterType != "" {
+			fmt.Fprintf(w, "%d. %s\t%s\t%d credits/hour\n", i+1, r.Name, r.DisplayName, cluster.RegionTier(r, clusterType).CreditsPerHour)
+		} else {
+			fmt.Fprintf(w, "%d. %s\t%s\n", i+1, r.Name, r.DisplayName)
+		}
 	}
+	w.Flush()
 	fmt.Print("\nSelect region (1-", len(regions), "): ")
 
 	var choice int
@@ -355,18 +519,52 @@ func getRegion(provider string) (string, error) {
 		return "", fmt.Errorf("invalid region selection")
 	}
 
-	return regions[choice-1], nil
+	return regions[choice-1].Name, nil
 }
 
-func getRoleType(provider string) string {
-	switch provider {
-	case "aws":
-		return "IAM Role"
-	case "gcp":
-		return "Service Account"
-	case "azure":
-		return "Service Principal"
-	default:
+// verifyBucketAccess runs the bucket access checks until they pass. After a failure it shows
+// which checks failed and how to fix them, and waits for the user to retry or cancel. Without
+// a terminal on stdin the first failure is final.
+func verifyBucketAccess(cmd *cobra.Command, session *auth.Session, cloudProvider, bucket, userRole string) error {
+	ops := bucketops.NewOperationsWithClient(session.Client, session.Config)
+	reader := bufio.NewReader(os.Stdin)
+
+	for {
+		ctx, cancel := session.Client.WithContext(cmd.Context())
+		done := make(chan bool)
+		go ShowLoading("Verifying bucket access", done)
+		resp, err := ops.VerifyAccess(ctx, cloudProvider, bucket, userRole)
+		done <- true
+		cancel()
+		if err != nil {
+			return err
+		}
+		if resp.HasAccess {
+			return nil
+		}
+
+		fmt.Println()
+		bucketops.WriteAccessChecks(os.Stdout, cloudProvider, bucket, userRole, resp)
+
+		// Without a terminal to answer the retry prompt, retrying would loop forever
+		if !utils.IsTerminal(os.Stdin) {
+			return fmt.Errorf("bucket access verification failed")
+		}
+
+		fmt.Print("\nPress Enter to verify again once fixed, or type 'q' to cancel: ")
+		answer, err := reader.ReadString('\n')
+		answer = strings.TrimSpace(answer)
+		if err != nil || answer == "q" || answer == "quit" {
+			return fmt.Errorf("bucket access verification failed")
+		}
+	}
+}
+
+// getRoleType returns what the cloud provider calls the identity buckets are accessed through
+func getRoleType(cloudProvider string) string {
+	prov, err := provider.Get(cloudProvider)
+	if err != nil {
 		return "Role"
 	}
+	return prov.RoleType()
 }
@@ -1,30 +1,110 @@
 package delete
 
 import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
 var (
-	bucketName  string
-	forceDelete bool
+	bucketName    string
+	forceDelete   bool
+	deleteObjects bool
 )
 
 // NewBucketCmd creates the delete bucket command
 func NewBucketCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "bucket",
+		Use:   "bucket [bucket-name]",
 		Short: "Delete a bucket",
-		Long:  `Delete a specific bucket from the NStream AI platform`,
+		Long: `Delete a specific bucket from the NStream AI platform.
+
+You will be asked to type the bucket name to confirm unless --force is given.
+A bucket that is still used by a cluster cannot be deleted, and a bucket that
+still contains objects is only deleted with --delete-objects. If the current
+context points at the deleted bucket, it is cleared from your configuration.`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// TODO: Implement bucket deletion logic
-			return nil
+			name := bucketName
+			if len(args) > 0 {
+				name = args[0]
+			}
+			if name == "" {
+				return fmt.Errorf("bucket name is required")
+			}
+
+			return deleteBucket(cmd, name)
 		},
 	}
 
 	cmd.Flags().StringVarP(&bucketName, "name", "n", "", "Bucket name")
 	cmd.Flags().BoolVarP(&forceDelete, "force", "f", false, "Force deletion without confirmation")
-
-	cmd.MarkFlagRequired("name")
+	cmd.Flags().BoolVar(&deleteObjects, "delete-objects", false, "Delete all objects in the bucket before deleting it")
 
 	return cmd
 }
+
+func deleteBucket(cmd *cobra.Command, name string) error {
+	session, err := auth.SessionFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	if !forceDelete {
+		confirmed, err := confirmBucketDeletion(name)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return fmt.Errorf("bucket name did not match, deletion cancelled")
+		}
+	}
+
+	ctx, cancel := session.Client.WithContext(cmd.Context())
+	defer cancel()
+
+	done := make(chan bool)
+	go utils.ShowDefaultLoading(fmt.Sprintf("Deleting bucket '%s'", name), done)
+
+	err = bucket.NewOperationsWithClient(session.Client, session.Config).DeleteBucket(ctx, name, deleteObjects)
+	done <- true
+	if err != nil {
+		return err
+	}
+
+	if session.Config.Cluster.Bucket == name {
+		session.Config.Cluster.Bucket = ""
+		if err := config.SaveConfig(session.Config); err != nil {
+			return fmt.Errorf("failed to save config: %v", err)
+		}
+		fmt.Printf("Cleared current bucket context '%s'. Run 'nsai use bucket' to select another bucket.\n", name)
+	}
+
+	fmt.Printf("\n%s✓ Successfully deleted bucket '%s'%s\n", utils.BoldColor, name, utils.ResetColor)
+	return nil
+}
+
+// confirmBucketDeletion asks the user to type the bucket name to confirm deletion
+func confirmBucketDeletion(name string) (bool, error) {
+	warning := "this will permanently delete bucket '%s'."
+	if deleteObjects {
+		warning = "this will permanently delete bucket '%s' and every object in it."
+	}
+	fmt.Printf("%s%sWARNING:%s "+warning+"\n", utils.BoldColor, utils.RedColor, utils.ResetColor, name)
+	fmt.Printf("Type the bucket name to confirm: ")
+
+	reader := bufio.NewReader(os.Stdin)
+	input, err := reader.ReadString('\n')
+	if err != nil {
+		return false, fmt.Errorf("failed to read input: %v", err)
+	}
+
+	return strings.TrimSpace(input) == name, nil
+}
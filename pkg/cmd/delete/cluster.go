@@ -1,30 +1,134 @@
 package delete
 
 import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
 var (
 	clusterName string
 	force       bool
+	wait        bool
+	waitTimeout time.Duration
 )
 
 // NewClusterCmd creates the delete cluster command
 func NewClusterCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "cluster",
+		Use:   "cluster [cluster-name]",
 		Short: "Delete a cluster",
-		Long:  `Delete a specific cluster from the NStream AI platform`,
+		Long: `Delete a specific cluster from the NStream AI platform.
+
+You will be asked to type the cluster name to confirm unless --force is given.
+Deletion runs asynchronously on the platform; use --wait to follow its progress
+until it completes. With --wait, a current context that points at the deleted
+cluster is cleared once the deletion succeeds; without it, the context is kept.`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// TODO: Implement cluster deletion logic
-			return nil
+			name := clusterName
+			if len(args) > 0 {
+				name = args[0]
+			}
+			if name == "" {
+				return fmt.Errorf("cluster name is required")
+			}
+
+			return deleteCluster(cmd, name)
 		},
 	}
 
 	cmd.Flags().StringVarP(&clusterName, "name", "n", "", "Cluster name")
 	cmd.Flags().BoolVarP(&force, "force", "f", false, "Force deletion without confirmation")
-
-	cmd.MarkFlagRequired("name")
+	cmd.Flags().BoolVarP(&wait, "wait", "w", false, "Wait for the deletion to complete")
+	cmd.Flags().DurationVar(&waitTimeout, "timeout", 30*time.Minute, "Maximum time to wait when --wait is set")
 
 	return cmd
 }
+
+func deleteCluster(cmd *cobra.Command, name string) error {
+	session, err := auth.SessionFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	if !force {
+		confirmed, err := confirmDeletion(name)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return fmt.Errorf("cluster name did not match, deletion cancelled")
+		}
+	}
+
+	ops := cluster.NewOperationsWithClient(session.Client, session.Config)
+
+	ctx, cancel := session.Client.WithContext(cmd.Context())
+	defer cancel()
+
+	done := make(chan bool)
+	go utils.ShowDefaultLoading(fmt.Sprintf("Requesting deletion of cluster '%s'", name), done)
+
+	op, err := ops.DeleteCluster(ctx, name)
+	done <- true
+	if err != nil {
+		return err
+	}
+
+	if !wait {
+		fmt.Printf("\n%sDeletion of cluster '%s' has started.%s\n", utils.BoldColor, name, utils.ResetColor)
+		fmt.Printf("  Operation: %s\n", op.Id)
+		fmt.Printf("  Phase: %s\n", op.Phase)
+		// Deletion can still fail, so the context is only cleared once it is known to succeed
+		if session.Config.Cluster.Name == name {
+			fmt.Printf("\nThe current cluster context still points to '%s'. Run 'nsai use cluster' to select another cluster once the deletion completes.\n", name)
+		}
+		fmt.Println("\nUse --wait to follow the deletion progress until it completes.")
+		return nil
+	}
+
+	waitCtx, waitCancel := context.WithTimeout(cmd.Context(), waitTimeout)
+	defer waitCancel()
+
+	fmt.Println()
+	display := cluster.NewProgressDisplay(os.Stdout)
+	_, err = ops.WaitForOperation(waitCtx, op.Id, 2*time.Second, display.UpdateOperation)
+	display.Finish()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%s✓ Successfully deleted cluster '%s'%s\n", utils.BoldColor, name, utils.ResetColor)
+
+	removed, err := ops.RemoveFromConfig(name)
+	if err != nil {
+		return fmt.Errorf("failed to save config: %v", err)
+	}
+	if removed {
+		fmt.Printf("Cleared current cluster context '%s'. Run 'nsai use cluster' to select another cluster.\n", name)
+	}
+	return nil
+}
+
+// confirmDeletion asks the user to type the cluster name to confirm deletion
+func confirmDeletion(name string) (bool, error) {
+	fmt.Printf("%s%sWARNING:%s this will permanently delete cluster '%s' and all of its resources.\n", utils.BoldColor, utils.RedColor, utils.ResetColor, name)
+	fmt.Printf("Type the cluster name to confirm: ")
+
+	reader := bufio.NewReader(os.Stdin)
+	input, err := reader.ReadString('\n')
+	if err != nil {
+		return false, fmt.Errorf("failed to read input: %v", err)
+	}
+
+	return strings.TrimSpace(input) == name, nil
+}
@@ -0,0 +1,26 @@
+package delete
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewDeleteCmd creates the root delete command
+func NewDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete NStream AI platform resources",
+		Long:  `Delete various NStream AI platform resources like clusters, buckets, etc.`,
+	}
+
+	// Add all subcommands
+	cmd.AddCommand(
+		NewClusterCmd(),
+		NewBucketCmd(),
+		NewStreamGraphCmd(),
+		NewStreamFinetunerCmd(),
+		NewBaseModelCmd(),
+		NewEmbeddingModelCmd(),
+	)
+
+	return cmd
+}
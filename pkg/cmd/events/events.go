@@ -0,0 +1,179 @@
+package events
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+	"github.com/spf13/cobra"
+)
+
+var (
+	clusterName string
+	kind        string
+	since       string
+	follow      bool
+	allClusters bool
+	listOpts    client.ListOptions
+)
+
+// NewEventsCmd creates the events command
+func NewEventsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Show cluster events",
+		Long: `Show the event log of a cluster and its resources, such as bucket attachment
+failures or base models that are not ready.
+
+Events of the current cluster context are shown unless --cluster or
+--all-clusters is given. --since accepts a duration (e.g. 1h) or an RFC 3339
+timestamp. With --follow, new events matching the same --kind and --filter are
+streamed until interrupted.
+
+Recorded events are fetched --chunk-size at a time and printed as each page
+arrives; --limit stops after the given number of events.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := listOpts.Validate(); err != nil {
+				return err
+			}
+
+			var sinceTime time.Time
+			if since != "" {
+				var err error
+				if sinceTime, err = utils.ParseSince(since); err != nil {
+					return fmt.Errorf("invalid --since value: %v", err)
+				}
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			name := clusterName
+			if name == "" && !allClusters {
+				name = session.Config.Cluster.Name
+				if name == "" {
+					return fmt.Errorf("no cluster selected. Use --cluster, --all-clusters or 'nsai use cluster'")
+				}
+			}
+
+			ops := cluster.NewOperationsWithClient(session.Client, session.Config)
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			// Rows are printed as each page arrives
+			it := ops.IterateEvents(name, kind, sinceTime, listOpts)
+			var (
+				lastSequence int64
+				count        int
+			)
+			for {
+				events, err := it.NextPage(ctx)
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return err
+				}
+
+				for _, ev := range events {
+					if count == 0 {
+						printHeader()
+					}
+					printEvent(ev)
+					count++
+					if ev.Sequence > lastSequence {
+						lastSequence = ev.Sequence
+					}
+				}
+			}
+
+			if count == 0 {
+				if !follow {
+					fmt.Println("No events found.")
+					return nil
+				}
+				printHeader()
+			}
+
+			if !follow {
+				return nil
+			}
+
+			// Events hidden by --limit are not replayed; follow on from the newest one recorded
+			if listOpts.Limit > 0 && count == listOpts.Limit {
+				ctx, cancel := session.Client.WithContext(cmd.Context())
+				defer cancel()
+				if lastSequence, err = ops.LatestEventSequence(ctx, name, kind, listOpts.Filter); err != nil {
+					return err
+				}
+			}
+
+			// Stop following on Ctrl-C without reporting an error
+			watchCtx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+
+			return ops.WatchEvents(watchCtx, name, kind, listOpts.Filter, lastSequence, printEvent)
+		},
+	}
+
+	cmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "Cluster name (default: current cluster context)")
+	cmd.Flags().BoolVarP(&allClusters, "all-clusters", "A", false, "Show events of all clusters")
+	cmd.Flags().StringVarP(&kind, "kind", "k", "", "Only show events of this resource kind (cluster, bucket, model, knowledgebase)")
+	cmd.Flags().StringVar(&since, "since", "", "Only show events newer than a duration (e.g. 1h) or RFC 3339 timestamp")
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Stream new events as they are recorded")
+	cmd.Flags().StringVar(&listOpts.Filter, "filter", "", "Server-side filter expression (e.g. \"severity=error\")")
+	cmd.Flags().StringVar(&listOpts.OrderBy, "sort-by", "", "Comma-separated fields to order by, each optionally followed by ' desc'")
+	cmd.Flags().IntVar(&listOpts.Limit, "limit", 0, "Maximum number of recorded events to list (0 lists all)")
+	cmd.Flags().Int32Var(&listOpts.PageSize, "chunk-size", client.DefaultPageSize, "Number of events fetched per request")
+
+	cmd.MarkFlagsMutuallyExclusive("cluster", "all-clusters")
+
+	return cmd
+}
+
+// Events are printed with fixed-width columns so rows streamed by --follow stay aligned
+const rowFormat = "%-20s  %-9s  %-20s  %-32s  %s\n"
+
+func printHeader() {
+	fmt.Printf(rowFormat, "TIME", "SEVERITY", "CLUSTER", "RESOURCE", "MESSAGE")
+}
+
+func printEvent(ev *clusterproto.Event) {
+	timestamp := ""
+	if ev.Timestamp != nil {
+		timestamp = ev.Timestamp.AsTime().Local().Format("2006-01-02 15:04:05")
+	}
+
+	resource := ev.Kind
+	if ev.Resource != "" {
+		resource += "/" + ev.Resource
+	}
+
+	message := ev.Message
+	if ev.Reason != "" {
+		message = ev.Reason + ": " + message
+	}
+
+	// Pad before coloring so escape codes do not break the column widths
+	severity := fmt.Sprintf("%-9s", strings.ToUpper(ev.Severity))
+	switch ev.Severity {
+	case cluster.SeverityError:
+		severity = utils.RedColor + severity + utils.ResetColor
+	case cluster.SeverityWarning:
+		severity = utils.BoldColor + severity + utils.ResetColor
+	}
+
+	fmt.Printf(rowFormat, timestamp, severity, ev.ClusterName, resource, message)
+}
@@ -1,28 +1,131 @@
 package get
 
 import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/labels"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/printer"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/provider"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
 	"github.com/spf13/cobra"
 )
 
 var (
 	bucketName         string
 	bucketOutputFormat string
+	bucketProvider     string
+	bucketSelector     string
+	bucketListOpts     client.ListOptions
 )
 
 // NewBucketCmd creates the get bucket command
 func NewBucketCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "bucket",
+		Use:   "bucket [bucket-name]",
 		Short: "Get bucket information",
-		Long:  `Get detailed information about a specific bucket or list all buckets`,
+		Long: `Get detailed information about a specific bucket or list all buckets.
+
+Use --provider to only list buckets of one cloud provider and --selector to only
+list buckets whose labels match. Buckets are fetched --chunk-size at a time and
+rows are printed as each page arrives.`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// TODO: Implement bucket get logic
+			if err := printer.ValidateFormat(bucketOutputFormat); err != nil {
+				return err
+			}
+			if err := bucketListOpts.Validate(); err != nil {
+				return err
+			}
+
+			sel, err := labels.ParseSelector(bucketSelector)
+			if err != nil {
+				return err
+			}
+
+			name := bucketName
+			if len(args) > 0 {
+				name = args[0]
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ops := bucket.NewOperationsWithClient(session.Client, session.Config)
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			opts := bucketPrintOptions()
+
+			if name != "" {
+				b, err := ops.GetBucket(ctx, name)
+				if err != nil {
+					return err
+				}
+				if !labels.Matches(sel, b.Labels) {
+					return fmt.Errorf("bucket '%s' does not match selector %q", name, bucketSelector)
+				}
+				return printer.PrintItem(os.Stdout, bucketOutputFormat, b, opts)
+			}
+
+			bucketListOpts.Selector = sel
+			n, err := printer.PrintPages(ctx, os.Stdout, bucketOutputFormat, ops.IterateBuckets(bucketProvider, bucketListOpts), opts)
+			if err != nil {
+				return err
+			}
+
+			if n == 0 && (bucketOutputFormat == printer.FormatTable || bucketOutputFormat == printer.FormatWide) {
+				fmt.Println("No buckets found.")
+			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVarP(&bucketName, "name", "n", "", "Bucket name (optional, lists all buckets if not specified)")
-	cmd.Flags().StringVarP(&bucketOutputFormat, "output", "o", "table", "Output format (table, json, yaml)")
+	cmd.Flags().StringVarP(&bucketOutputFormat, "output", "o", printer.FormatTable, printer.FormatHelp())
+	cmd.Flags().StringVarP(&bucketProvider, "provider", "p", "", "Only list buckets of this cloud provider ("+strings.Join(provider.Names(), ", ")+")")
+	cmd.Flags().StringVarP(&bucketSelector, "selector", "l", "", "Label selector to filter on (e.g. env=prod,team!=ml)")
+	cmd.Flags().StringVar(&bucketListOpts.Filter, "filter", "", "Server-side filter expression (e.g. \"region=us-east-1\")")
+	cmd.Flags().StringVar(&bucketListOpts.OrderBy, "sort-by", "", "Comma-separated fields to order by, each optionally followed by ' desc'")
+	cmd.Flags().IntVar(&bucketListOpts.Limit, "limit", 0, "Maximum number of buckets to list (0 lists all)")
+	cmd.Flags().Int32Var(&bucketListOpts.PageSize, "chunk-size", client.DefaultPageSize, "Number of buckets fetched per request")
 
 	return cmd
 }
+
+// bucketPrintOptions returns the printer options for buckets
+func bucketPrintOptions() printer.Options[*clusterproto.Bucket] {
+	return printer.Options[*clusterproto.Bucket]{
+		Kind: "bucket",
+		Name: func(b *clusterproto.Bucket) string { return b.Name },
+		Columns: []printer.Column[*clusterproto.Bucket]{
+			{Header: "NAME", Value: func(b *clusterproto.Bucket) string { return b.Name }},
+			{Header: "PROVIDER", Value: func(b *clusterproto.Bucket) string { return b.Provider }},
+			{Header: "REGION", Value: func(b *clusterproto.Bucket) string { return b.Region }},
+			{Header: "STORAGE CLASS", Value: func(b *clusterproto.Bucket) string { return b.StorageClass }},
+			{Header: "SIZE", Value: func(b *clusterproto.Bucket) string { return b.Size }},
+			{Header: "VERSIONING", Wide: true, Value: func(b *clusterproto.Bucket) string {
+				if b.Versioning {
+					return "enabled"
+				}
+				return "disabled"
+			}},
+			{Header: "KMS KEY", Wide: true, Value: func(b *clusterproto.Bucket) string { return b.KmsKey }},
+			{Header: "ENDPOINT", Wide: true, Value: func(b *clusterproto.Bucket) string { return b.S3Compatible.GetEndpoint() }},
+			{Header: "CREATED", Wide: true, Value: func(b *clusterproto.Bucket) string {
+				if b.CreatedAt == nil {
+					return ""
+				}
+				return b.CreatedAt.AsTime().Local().Format("2006-01-02 15:04")
+			}},
+			{Header: "LABELS", Wide: true, Value: func(b *clusterproto.Bucket) string { return labels.Format(b.Labels) }},
+		},
+	}
+}
@@ -1,12 +1,27 @@
 package get
 
 import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/labels"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/printer"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
 	clusterName  string
 	outputFormat string
+	showSecrets  bool
+	watch        bool
+	selector     string
+	listOpts     client.ListOptions
 )
 
 // NewClusterCmd creates the get cluster command
@@ -14,15 +29,163 @@ func NewClusterCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "cluster",
 		Short: "Get cluster information",
-		Long:  `Get detailed information about a specific cluster or list all clusters`,
+		Long: `Get detailed information about a specific cluster or list all clusters.
+
+The PHASE column shows whether a cluster is ready, still provisioning or paused.
+The cluster token is never printed unless --show-secrets is given together with
+--name; listed clusters do not include their tokens.
+
+With --watch and --name, the cluster's provisioning phases are streamed live
+until it is ready or provisioning fails.
+
+Use --selector to list only clusters whose labels match, for example
+-l env=prod,team!=ml or -l 'tier in (gold,silver)'.
+
+Clusters are fetched --chunk-size at a time and rows are printed as each page
+arrives. --limit stops after the given number of clusters.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// TODO: Implement cluster get logic
+			if err := printer.ValidateFormat(outputFormat); err != nil {
+				return err
+			}
+			if err := listOpts.Validate(); err != nil {
+				return err
+			}
+			if showSecrets && clusterName == "" {
+				return fmt.Errorf("--show-secrets requires --name, listed clusters do not include their tokens")
+			}
+
+			sel, err := labels.ParseSelector(selector)
+			if err != nil {
+				return err
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ops := cluster.NewOperationsWithClient(session.Client, session.Config)
+
+			if watch {
+				if clusterName == "" {
+					return fmt.Errorf("--watch requires --name")
+				}
+
+				display := cluster.NewProgressDisplay(os.Stdout)
+				_, err := ops.WatchCluster(cmd.Context(), clusterName, display.Update)
+				display.Finish()
+				return err
+			}
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			opts := clusterPrintOptions(showSecrets)
+
+			if clusterName != "" {
+				details, err := ops.GetClusterDetails(ctx, clusterName)
+				if err != nil {
+					return err
+				}
+				if !labels.Matches(sel, details.Labels) {
+					return fmt.Errorf("cluster '%s' does not match selector %q", clusterName, selector)
+				}
+				return printer.PrintItem(os.Stdout, outputFormat, redactCluster(details, showSecrets), opts)
+			}
+
+			listOpts.Selector = sel
+			n, err := printer.PrintPages(ctx, os.Stdout, outputFormat, clusterConfigPager{ops.IterateClusters(listOpts)}, opts)
+			if err != nil {
+				return err
+			}
+
+			if n == 0 && (outputFormat == printer.FormatTable || outputFormat == printer.FormatWide) {
+				fmt.Println("No clusters found.")
+			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVarP(&clusterName, "name", "n", "", "Cluster name (optional, lists all clusters if not specified)")
-	cmd.Flags().StringVarP(&outputFormat, "output", "o", "table", "Output format (table, json, yaml)")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", printer.FormatTable, printer.FormatHelp())
+	cmd.Flags().BoolVar(&showSecrets, "show-secrets", false, "Include the cluster token in the output (requires --name)")
+	cmd.Flags().BoolVarP(&watch, "watch", "w", false, "Stream provisioning phases of the named cluster")
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "Label selector to filter on (e.g. env=prod,team!=ml)")
+	cmd.Flags().StringVar(&listOpts.Filter, "filter", "", "Server-side filter expression (e.g. \"phase=ready AND region=us-east-1\")")
+	cmd.Flags().StringVar(&listOpts.OrderBy, "sort-by", "", "Comma-separated fields to order by, each optionally followed by ' desc'")
+	cmd.Flags().IntVar(&listOpts.Limit, "limit", 0, "Maximum number of clusters to list (0 lists all)")
+	cmd.Flags().Int32Var(&listOpts.PageSize, "chunk-size", client.DefaultPageSize, "Number of clusters fetched per request")
 
 	return cmd
 }
+
+// clusterPrintOptions returns the printer options for clusters
+func clusterPrintOptions(showSecrets bool) printer.Options[*clusterproto.ClusterConfig] {
+	columns := []printer.Column[*clusterproto.ClusterConfig]{
+		{Header: "NAME", Value: func(c *clusterproto.ClusterConfig) string { return c.Name }},
+		{Header: "TYPE", Value: func(c *clusterproto.ClusterConfig) string { return c.Type }},
+		{Header: "CLOUD", Value: func(c *clusterproto.ClusterConfig) string { return c.CloudProvider }},
+		{Header: "REGION", Value: func(c *clusterproto.ClusterConfig) string { return c.Region }},
+		{Header: "BUCKET", Value: func(c *clusterproto.ClusterConfig) string { return c.Bucket }},
+		{Header: "PHASE", Value: func(c *clusterproto.ClusterConfig) string { return c.Phase }},
+		{Header: "REPLICAS", Wide: true, Value: func(c *clusterproto.ClusterConfig) string { return fmt.Sprint(c.Replicas) }},
+		{Header: "SIZE", Wide: true, Value: func(c *clusterproto.ClusterConfig) string { return c.Size }},
+		{Header: "IDENTITY", Wide: true, Value: func(c *clusterproto.ClusterConfig) string { return c.Role }},
+		{Header: "LABELS", Wide: true, Value: func(c *clusterproto.ClusterConfig) string { return labels.Format(c.Labels) }},
+	}
+
+	if showSecrets {
+		columns = append(columns, printer.Column[*clusterproto.ClusterConfig]{
+			Header: "TOKEN",
+			Wide:   true,
+			Value:  func(c *clusterproto.ClusterConfig) string { return c.ClusterToken },
+		})
+	}
+
+	return printer.Options[*clusterproto.ClusterConfig]{
+		Kind:    "cluster",
+		Name:    func(c *clusterproto.ClusterConfig) string { return c.Name },
+		Columns: columns,
+	}
+}
+
+// redactCluster returns a copy of the cluster config without its token unless secrets are requested
+func redactCluster(c *clusterproto.ClusterConfig, showSecrets bool) *clusterproto.ClusterConfig {
+	if showSecrets {
+		return c
+	}
+
+	redacted := proto.Clone(c).(*clusterproto.ClusterConfig)
+	redacted.ClusterToken = ""
+	return redacted
+}
+
+// clusterConfigPager converts pages of listed clusters into the cluster configs printed by get cluster
+type clusterConfigPager struct {
+	it *client.Iterator[*clusterproto.Cluster]
+}
+
+func (p clusterConfigPager) NextPage(ctx context.Context) ([]*clusterproto.ClusterConfig, error) {
+	clusters, err := p.it.NextPage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := make([]*clusterproto.ClusterConfig, 0, len(clusters))
+	for _, c := range clusters {
+		configs = append(configs, &clusterproto.ClusterConfig{
+			Name:          c.Id,
+			Region:        c.Region,
+			CloudProvider: c.CloudProvider,
+			Bucket:        c.Bucket,
+			Role:          c.Role,
+			Phase:         c.Phase,
+			Type:          c.Type,
+			Replicas:      c.Replicas,
+			Size:          c.Size,
+			Labels:        c.Labels,
+			Annotations:   c.Annotations,
+		})
+	}
+	return configs, nil
+}
@@ -1,12 +1,14 @@
 package init
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"time"
 
-	"github.com/nstreama-ai/nstream-ai-cli/pkg/api"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
 	"github.com/spf13/cobra"
 )
@@ -75,7 +77,8 @@ Examples:
 	cmd.Flags().StringVar(&bucket, "bucket", "", "Bucket name for cluster creation")
 	cmd.Flags().StringVar(&role, "role", "", "Role for cluster creation")
 
-	return cmd
+	// init drives sign in / sign up itself when credentials are missing
+	return auth.SkipAuth(cmd)
 }
 
 func ensureAuthentication() error {
@@ -100,40 +103,36 @@ func ensureAuthentication() error {
 		return promptForAuth()
 	}
 
-	// Check if user exists
-	valid, err := api.MockValidateUser(cfg.User.Email)
-	if err != nil || !valid {
-		fmt.Println("\nUser validation failed. Authentication required.")
-		return promptForAuth()
+	// Validate credentials against the auth service
+	validator, err := auth.NewValidator()
+	if err != nil {
+		return err
 	}
+	defer validator.Close()
 
-	// Check if token is valid
-	resp, err := api.MockValidateToken(cfg.User.AuthToken)
-	if err != nil {
-		return fmt.Errorf("error validating token: %v", err)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := validator.ValidateUser(ctx); err != nil {
+		fmt.Printf("\nUser validation failed: %v\n", err)
+		fmt.Println("Authentication required.")
+		return promptForAuth()
 	}
 
-	if !resp.Valid {
-		fmt.Printf("\nAuthentication token is invalid: %s\n", resp.Error)
+	if err := validator.ValidateToken(ctx); err != nil {
+		fmt.Printf("\n%v\n", err)
 		fmt.Println("Authentication required.")
 		return promptForAuth()
 	}
 
 	// If cluster token exists, validate it too
-	if cfg.Cluster.ClusterToken != "" {
-		clusterResp, err := api.MockValidateClusterToken(cfg.Cluster.ClusterToken)
-		if err != nil {
-			return fmt.Errorf("error validating cluster token: %v", err)
-		}
-
-		if !clusterResp.Valid {
-			fmt.Printf("\nCluster token is invalid: %s\n", clusterResp.Error)
-			// Don't require re-authentication for invalid cluster token
-			// Just clear it from config
-			cfg.Cluster.ClusterToken = ""
-			if err := config.SaveConfig(cfg); err != nil {
-				return fmt.Errorf("error saving config: %v", err)
-			}
+	if err := validator.ValidateClusterToken(ctx); err != nil {
+		fmt.Printf("\n%v\n", err)
+		// Don't require re-authentication for invalid cluster token
+		// Just clear it from config
+		cfg.Cluster.ClusterToken = ""
+		if err := config.SaveConfig(cfg); err != nil {
+			return fmt.Errorf("error saving config: %v", err)
 		}
 	}
 
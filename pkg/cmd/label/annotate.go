@@ -0,0 +1,29 @@
+package label
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewAnnotateCmd creates the annotate command
+func NewAnnotateCmd() *cobra.Command {
+	opts := &metadataOptions{field: fieldAnnotations, verb: "annotated"}
+
+	return newMetadataCmd(
+		"annotate <cluster|bucket> <name> key=value... [key-...]",
+		"Update the annotations on a resource",
+		`Add, change or remove annotations on a cluster or bucket.
+
+Annotations are non-identifying key/value pairs such as owners, ticket links or
+descriptions. Keys follow the same rules as label keys; values may be any string.
+Annotations cannot be used in selectors.
+
+A trailing dash removes an annotation. Changing an annotation that is already
+set requires --overwrite.
+
+Examples:
+  nsai annotate cluster prod owner=ml-platform@example.com
+  nsai annotate bucket training-data description="Raw event exports"
+  nsai annotate cluster prod owner-`,
+		opts,
+	)
+}
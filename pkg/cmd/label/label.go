@@ -0,0 +1,31 @@
+package label
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewLabelCmd creates the label command
+func NewLabelCmd() *cobra.Command {
+	opts := &metadataOptions{field: fieldLabels, verb: "labeled"}
+
+	return newMetadataCmd(
+		"label <cluster|bucket> <name> key=value... [key-...]",
+		"Update the labels on a resource",
+		`Add, change or remove labels on a cluster or bucket.
+
+Labels are identifying key/value pairs that list and get commands can filter on
+with -l/--selector. Keys are at most 63 alphanumerics, '-', '_' or '.', with an
+optional DNS-style prefix (e.g. team.example.com/owner). Values follow the same
+rules without a prefix and may be empty.
+
+A trailing dash removes a label. Changing a label that is already set requires
+--overwrite.
+
+Examples:
+  nsai label cluster prod env=prod team=ml
+  nsai label cluster prod team=search --overwrite
+  nsai label bucket training-data tier-
+  nsai label cluster prod --list`,
+		opts,
+	)
+}
@@ -0,0 +1,151 @@
+package label
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/labels"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+	"github.com/spf13/cobra"
+)
+
+// Resource kinds that carry labels and annotations
+const (
+	kindCluster = "cluster"
+	kindBucket  = "bucket"
+)
+
+// Metadata fields, named as in the update mask
+const (
+	fieldLabels      = "labels"
+	fieldAnnotations = "annotations"
+)
+
+// metadataOptions describe one label or annotate invocation
+type metadataOptions struct {
+	field     string
+	verb      string
+	overwrite bool
+	list      bool
+}
+
+// newMetadataCmd builds the shared "<verb> <kind> <name> key=value... key-..." command
+func newMetadataCmd(use, short, long string, opts *metadataOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:       use,
+		Short:     short,
+		Long:      long,
+		Args:      cobra.MinimumNArgs(2),
+		ValidArgs: []string{kindCluster, kindBucket},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind, name, changeArgs := args[0], args[1], args[2:]
+			if kind != kindCluster && kind != kindBucket {
+				return fmt.Errorf("unsupported resource kind '%s' (must be %s or %s)", kind, kindCluster, kindBucket)
+			}
+			if opts.list && len(changeArgs) > 0 {
+				return fmt.Errorf("--list cannot be combined with key=value or key- arguments")
+			}
+			if !opts.list && len(changeArgs) == 0 {
+				return fmt.Errorf("at least one key=value or key- argument is required")
+			}
+
+			// Annotation values are free-form; only label values are restricted
+			changes, err := labels.ParseChanges(changeArgs, opts.field == fieldLabels)
+			if err != nil {
+				return err
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			if kind == kindCluster {
+				ops := cluster.NewOperationsWithClient(session.Client, session.Config)
+				details, err := ops.GetClusterDetails(ctx, name)
+				if err != nil {
+					return err
+				}
+
+				current := details.Labels
+				if opts.field == fieldAnnotations {
+					current = details.Annotations
+				}
+				if opts.list {
+					printMetadata(current)
+					return nil
+				}
+
+				updated, err := changes.Apply(current, opts.overwrite)
+				if err != nil {
+					return err
+				}
+
+				update := &clusterproto.ClusterConfig{Labels: updated}
+				if opts.field == fieldAnnotations {
+					update = &clusterproto.ClusterConfig{Annotations: updated}
+				}
+				if _, err := ops.UpdateCluster(ctx, name, update, []string{opts.field}, false); err != nil {
+					return err
+				}
+			} else {
+				ops := bucket.NewOperationsWithClient(session.Client, session.Config)
+				b, err := ops.GetBucket(ctx, name)
+				if err != nil {
+					return err
+				}
+
+				current := b.Labels
+				if opts.field == fieldAnnotations {
+					current = b.Annotations
+				}
+				if opts.list {
+					printMetadata(current)
+					return nil
+				}
+
+				updated, err := changes.Apply(current, opts.overwrite)
+				if err != nil {
+					return err
+				}
+
+				update := &clusterproto.Bucket{Labels: updated}
+				if opts.field == fieldAnnotations {
+					update = &clusterproto.Bucket{Annotations: updated}
+				}
+				if _, err := ops.UpdateBucket(ctx, name, update, []string{opts.field}); err != nil {
+					return err
+				}
+			}
+
+			fmt.Printf("%s✓ %s/%s %s%s\n", utils.GreenColor, kind, name, opts.verb, utils.ResetColor)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.overwrite, "overwrite", false, "Allow changing the value of keys that are already set")
+	cmd.Flags().BoolVar(&opts.list, "list", false, "List the current keys and values instead of changing them")
+
+	return cmd
+}
+
+// printMetadata prints key=value pairs sorted by key
+func printMetadata(values map[string]string) {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fmt.Fprintf(os.Stdout, "%s=%s\n", k, values[k])
+	}
+}
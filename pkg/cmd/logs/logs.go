@@ -0,0 +1,187 @@
+package logs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	logsops "github.com/nstreama-ai/nstream-ai-cli/pkg/logs"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	logsproto "github.com/nstreama-ai/nstream-ai-cli/proto/logs"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	clusterName  string
+	follow       bool
+	since        string
+	tail         int32
+	timestamps   bool
+	replica      string
+	outputFormat string
+)
+
+// sourceColors are cycled through to tell replicas apart on a terminal
+var sourceColors = []string{utils.CyanColor, utils.GreenColor, utils.YellowColor, utils.MagentaColor, utils.BlueColor}
+
+// NewLogsCmd creates the logs command
+func NewLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs <kind>/<name>",
+		Short: "Show the logs of a resource",
+		Long: fmt.Sprintf(`Show what a resource running on a cluster prints at runtime.
+
+<kind> is one of %s. Lines of all replicas are shown, prefixed with
+their replica name, unless --replica is given. On a terminal each replica gets
+its own color; with -o json every line is printed as a JSON object (JSONL).
+
+With --follow, new lines are streamed until interrupted. A dropped stream is
+reopened from the last line received.`, strings.Join(logsops.Kinds, ", ")),
+		Example: `  # Follow the logs of a stream graph
+  nsai logs graph/my-graph -f
+
+  # Last 100 lines of one connector replica with timestamps
+  nsai logs connector/kafka-in --replica kafka-in-0 --tail 100 --timestamps`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind, name, err := parseResource(args[0])
+			if err != nil {
+				return err
+			}
+			if outputFormat != "text" && outputFormat != "json" {
+				return fmt.Errorf("unsupported output format %q (must be one of text, json)", outputFormat)
+			}
+			if tail < 0 {
+				return fmt.Errorf("--tail must not be negative")
+			}
+
+			opts := logsops.StreamOptions{
+				Kind:    kind,
+				Name:    name,
+				Replica: replica,
+				Tail:    tail,
+				Follow:  follow,
+			}
+			if since != "" {
+				if opts.Since, err = utils.ParseSince(since); err != nil {
+					return fmt.Errorf("invalid --since value: %v", err)
+				}
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			opts.ClusterName = clusterName
+			if opts.ClusterName == "" {
+				opts.ClusterName = session.Config.Cluster.Name
+				if opts.ClusterName == "" {
+					return fmt.Errorf("no cluster selected. Use --cluster or 'nsai use cluster'")
+				}
+			}
+
+			// Stop streaming on Ctrl-C without reporting an error
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+
+			w := newLogWriter(os.Stdout, outputFormat == "json", utils.IsTerminal(os.Stdout))
+			if err := logsops.NewOperationsWithClient(session.Client, session.Config).StreamLogs(ctx, opts, w.write); err != nil {
+				return err
+			}
+			return w.err
+		},
+	}
+
+	cmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "Cluster name (default: current cluster context)")
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Stream new lines as they are logged")
+	cmd.Flags().StringVar(&since, "since", "", "Only show lines newer than a duration (e.g. 1h) or RFC 3339 timestamp")
+	cmd.Flags().Int32Var(&tail, "tail", 0, "Number of recent lines to show per replica (0 shows all)")
+	cmd.Flags().BoolVar(&timestamps, "timestamps", false, "Prefix each line with its timestamp")
+	cmd.Flags().StringVar(&replica, "replica", "", "Only show lines of this replica")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", "text", "Output format (text, json)")
+
+	return cmd
+}
+
+// parseResource splits a <kind>/<name> argument
+func parseResource(arg string) (string, string, error) {
+	kind, name, ok := strings.Cut(arg, "/")
+	if !ok || kind == "" || name == "" {
+		return "", "", fmt.Errorf("resource must be given as <kind>/<name>, e.g. graph/my-graph")
+	}
+
+	for _, k := range logsops.Kinds {
+		if kind == k {
+			return kind, name, nil
+		}
+	}
+	return "", "", fmt.Errorf("unsupported resource kind %q (must be one of %s)", kind, strings.Join(logsops.Kinds, ", "))
+}
+
+// logWriter prints log entries as text or JSONL, coloring replica prefixes on a terminal
+type logWriter struct {
+	out    io.Writer
+	jsonl  bool
+	color  bool
+	colors map[string]string
+	err    error
+}
+
+func newLogWriter(out io.Writer, jsonl, color bool) *logWriter {
+	return &logWriter{out: out, jsonl: jsonl, color: color, colors: map[string]string{}}
+}
+
+func (w *logWriter) write(entry *logsproto.LogEntry) {
+	if w.err != nil {
+		return
+	}
+
+	if w.jsonl {
+		data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(entry)
+		if err != nil {
+			w.err = fmt.Errorf("failed to encode log entry: %v", err)
+			return
+		}
+		// protojson output is deliberately unstable, so compact it to one line per entry
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, data); err != nil {
+			w.err = fmt.Errorf("failed to encode log entry: %v", err)
+			return
+		}
+		buf.WriteByte('\n')
+		_, w.err = w.out.Write(buf.Bytes())
+		return
+	}
+
+	var prefix string
+	if timestamps && entry.Timestamp != nil {
+		prefix = entry.Timestamp.AsTime().Local().Format(time.RFC3339Nano) + " "
+	}
+	if replica == "" && entry.Replica != "" {
+		source := "[" + entry.Replica + "]"
+		if w.color {
+			source = w.colorFor(entry.Replica) + source + utils.ResetColor
+		}
+		prefix += source + " "
+	}
+
+	_, w.err = fmt.Fprintf(w.out, "%s%s\n", prefix, strings.TrimRight(entry.Line, "\n"))
+}
+
+// colorFor assigns each replica a stable color in order of first appearance
+func (w *logWriter) colorFor(source string) string {
+	c, ok := w.colors[source]
+	if !ok {
+		c = sourceColors[len(w.colors)%len(sourceColors)]
+		w.colors[source] = c
+	}
+	return c
+}
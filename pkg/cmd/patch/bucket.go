@@ -1,31 +1,131 @@
 package patch
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/provider"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
 	"github.com/spf13/cobra"
 )
 
 var (
-	bucketName string
-	bucketSpec string
+	bucketName     string
+	bucketSpec     string
+	bucketSpecFile string
+	bucketPatch    string
+	bucketDryRun   bool
 )
 
+// immutableBucketFields cannot be changed through a patch
+var immutableBucketFields = []string{"name", "region", "provider", "size", "created_at"}
+
 // NewBucketCmd creates the patch bucket command
 func NewBucketCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "bucket",
+		Use:   "bucket [bucket-name]",
 		Short: "Patch a bucket",
-		Long:  `Patch a specific bucket in the NStream AI platform`,
+		Long: `Patch a specific bucket in the NStream AI platform.
+
+The patch is applied client-side to the bucket's current settings and only the
+fields it changes are sent to the server. The storage class, versioning, labels
+and annotations can be changed; name, region and provider cannot.
+Two patch types are supported:
+  merge  JSON Merge Patch (RFC 7386), e.g. '{"versioning": true}'
+  json   JSON Patch (RFC 6902), e.g. '[{"op": "replace", "path": "/storage_class", "value": "NEARLINE"}]'
+
+The patch can be given inline with --spec or read from a file with --filename
+(use '-' to read from stdin). Use --dry-run to preview the change locally.`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// TODO: Implement bucket patching logic
-			return nil
+			name := bucketName
+			if len(args) > 0 {
+				name = args[0]
+			}
+			if name == "" {
+				return fmt.Errorf("bucket name is required")
+			}
+
+			return patchBucket(cmd, name)
 		},
 	}
 
 	cmd.Flags().StringVarP(&bucketName, "name", "n", "", "Bucket name")
-	cmd.Flags().StringVarP(&bucketSpec, "spec", "s", "", "Bucket specification in JSON format")
+	cmd.Flags().StringVarP(&bucketSpec, "spec", "s", "", "Bucket patch in JSON format")
+	cmd.Flags().StringVarP(&bucketSpecFile, "filename", "f", "", "File containing the bucket patch ('-' for stdin)")
+	cmd.Flags().StringVar(&bucketPatch, "type", "merge", "Patch type (merge, json)")
+	cmd.Flags().BoolVar(&bucketDryRun, "dry-run", false, "Show the change without applying it")
 
-	cmd.MarkFlagRequired("name")
-	cmd.MarkFlagRequired("spec")
+	cmd.MarkFlagsMutuallyExclusive("spec", "filename")
+	cmd.MarkFlagsOneRequired("spec", "filename")
 
 	return cmd
 }
+
+func patchBucket(cmd *cobra.Command, name string) error {
+	patch, err := readSpec(bucketSpec, bucketSpecFile)
+	if err != nil {
+		return err
+	}
+
+	session, err := auth.SessionFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	ops := bucket.NewOperationsWithClient(session.Client, session.Config)
+
+	ctx, cancel := session.Client.WithContext(cmd.Context())
+	defer cancel()
+
+	before, err := ops.GetBucket(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	after := &clusterproto.Bucket{}
+	if err := applyPatch(before, after, patch, bucketPatch, "bucket", immutableBucketFields); err != nil {
+		return err
+	}
+
+	updateMask := changedFields(before, after)
+	if len(updateMask) == 0 {
+		fmt.Printf("bucket '%s' unchanged\n", name)
+		return nil
+	}
+
+	if after.StorageClass != before.StorageClass {
+		prov, err := provider.Get(before.Provider)
+		if err != nil {
+			return err
+		}
+		if err := provider.ValidateStorageClass(prov, after.StorageClass); err != nil {
+			return err
+		}
+	}
+
+	result := after
+	if !bucketDryRun {
+		result, err = ops.UpdateBucket(ctx, name, after, updateMask)
+		if err != nil {
+			return err
+		}
+	}
+
+	diff, err := renderDiff(before, result, "bucket")
+	if err != nil {
+		return err
+	}
+	fmt.Print(diff)
+
+	if bucketDryRun {
+		fmt.Printf("\nbucket '%s' patched (dry run)\n", name)
+		return nil
+	}
+
+	fmt.Printf("\n%s✓ bucket '%s' patched (%s)%s\n", utils.BoldColor, name, strings.Join(updateMask, ", "), utils.ResetColor)
+	return nil
+}
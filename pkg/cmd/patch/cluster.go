@@ -1,31 +1,253 @@
 package patch
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/jsonpatch"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
-	clusterName string
-	clusterSpec string
+	clusterName     string
+	clusterSpec     string
+	clusterSpecFile string
+	clusterPatch    string
+	clusterDryRun   string
 )
 
+// immutableClusterFields cannot be changed through a patch
+var immutableClusterFields = []string{"name", "cluster_token"}
+
 // NewClusterCmd creates the patch cluster command
 func NewClusterCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "cluster",
+		Use:   "cluster [cluster-name]",
 		Short: "Patch a cluster",
-		Long:  `Patch a specific cluster in the NStream AI platform`,
+		Long: `Patch a specific cluster in the NStream AI platform.
+
+The patch is applied client-side to the cluster's current configuration and only
+the fields it changes are sent to the server. Two patch types are supported:
+  merge  JSON Merge Patch (RFC 7386), e.g. '{"region": "us-east-1"}'
+  json   JSON Patch (RFC 6902), e.g. '[{"op": "replace", "path": "/region", "value": "us-east-1"}]'
+
+The patch can be given inline with --spec or read from a file with --filename
+(use '-' to read from stdin).
+
+Use --dry-run=client to preview the change locally, or --dry-run=server to have
+the server validate it and return the resulting configuration without applying it.`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// TODO: Implement cluster patching logic
-			return nil
+			name := clusterName
+			if len(args) > 0 {
+				name = args[0]
+			}
+			if name == "" {
+				return fmt.Errorf("cluster name is required")
+			}
+
+			return patchCluster(cmd, name)
 		},
 	}
 
 	cmd.Flags().StringVarP(&clusterName, "name", "n", "", "Cluster name")
-	cmd.Flags().StringVarP(&clusterSpec, "spec", "s", "", "Cluster specification in JSON format")
+	cmd.Flags().StringVarP(&clusterSpec, "spec", "s", "", "Cluster patch in JSON format")
+	cmd.Flags().StringVarP(&clusterSpecFile, "filename", "f", "", "File containing the cluster patch ('-' for stdin)")
+	cmd.Flags().StringVar(&clusterPatch, "type", "merge", "Patch type (merge, json)")
+	cmd.Flags().StringVar(&clusterDryRun, "dry-run", "none", "Preview the change without applying it (none, client, server)")
 
-	cmd.MarkFlagRequired("name")
-	cmd.MarkFlagRequired("spec")
+	cmd.MarkFlagsMutuallyExclusive("spec", "filename")
+	cmd.MarkFlagsOneRequired("spec", "filename")
 
 	return cmd
 }
+
+func patchCluster(cmd *cobra.Command, name string) error {
+	switch clusterDryRun {
+	case "none", "client", "server":
+	default:
+		return fmt.Errorf("invalid --dry-run value %q (must be none, client or server)", clusterDryRun)
+	}
+
+	patch, err := readSpec(clusterSpec, clusterSpecFile)
+	if err != nil {
+		return err
+	}
+
+	session, err := auth.SessionFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	ops := cluster.NewOperationsWithClient(session.Client, session.Config)
+
+	ctx, cancel := session.Client.WithContext(cmd.Context())
+	defer cancel()
+
+	before, err := ops.GetClusterDetails(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	after, err := applyClusterPatch(before, patch, clusterPatch)
+	if err != nil {
+		return err
+	}
+
+	updateMask := changedFields(before, after)
+	if len(updateMask) == 0 {
+		fmt.Printf("cluster '%s' unchanged\n", name)
+		return nil
+	}
+
+	result := after
+	if clusterDryRun != "client" {
+		result, err = ops.UpdateCluster(ctx, name, after, updateMask, clusterDryRun == "server")
+		if err != nil {
+			return err
+		}
+	}
+
+	diff, err := clusterDiff(before, result)
+	if err != nil {
+		return err
+	}
+	fmt.Print(diff)
+
+	switch clusterDryRun {
+	case "none":
+		fmt.Printf("\n%s✓ cluster '%s' patched (%s)%s\n", utils.BoldColor, name, strings.Join(updateMask, ", "), utils.ResetColor)
+	default:
+		fmt.Printf("\ncluster '%s' patched (dry run: %s)\n", name, clusterDryRun)
+	}
+
+	return nil
+}
+
+// readSpec returns the patch from the inline spec or from the given file, where "-" is stdin
+func readSpec(spec, file string) ([]byte, error) {
+	if file == "" {
+		return []byte(spec), nil
+	}
+
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read patch: %v", err)
+	}
+
+	return data, nil
+}
+
+// applyClusterPatch applies a merge or JSON patch to the cluster config and returns the patched copy
+func applyClusterPatch(current *clusterproto.ClusterConfig, patch []byte, patchType string) (*clusterproto.ClusterConfig, error) {
+	result := &clusterproto.ClusterConfig{}
+	if err := applyPatch(current, result, patch, patchType, "cluster config", immutableClusterFields); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// applyPatch applies a merge or JSON patch to current and stores the outcome in result. Patches
+// that change one of the immutable fields are rejected.
+func applyPatch(current, result proto.Message, patch []byte, patchType, what string, immutable []string) error {
+	doc, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(current)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %v", what, err)
+	}
+
+	var patched []byte
+	switch patchType {
+	case "merge":
+		patched, err = jsonpatch.MergePatch(doc, patch)
+	case "json":
+		patched, err = jsonpatch.Apply(doc, patch)
+	default:
+		return fmt.Errorf("invalid patch type %q (must be merge or json)", patchType)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := protojson.Unmarshal(patched, result); err != nil {
+		return fmt.Errorf("patched %s is invalid: %v", what, err)
+	}
+
+	for _, field := range changedFields(current, result) {
+		for _, f := range immutable {
+			if field == f {
+				return fmt.Errorf("field %q cannot be patched", field)
+			}
+		}
+	}
+
+	return nil
+}
+
+// changedFields returns the names of the top-level fields that differ between a and b
+func changedFields(a, b proto.Message) []string {
+	var fields []string
+	ra, rb := a.ProtoReflect(), b.ProtoReflect()
+	fds := ra.Descriptor().Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		if !ra.Get(fd).Equal(rb.Get(fd)) {
+			fields = append(fields, string(fd.Name()))
+		}
+	}
+	return fields
+}
+
+// clusterDiff renders a colored diff of two cluster configs with the cluster token redacted
+func clusterDiff(before, after *clusterproto.ClusterConfig) (string, error) {
+	redact := func(cfg *clusterproto.ClusterConfig) *clusterproto.ClusterConfig {
+		redacted := proto.Clone(cfg).(*clusterproto.ClusterConfig)
+		redacted.ClusterToken = ""
+		return redacted
+	}
+
+	return renderDiff(redact(before), redact(after), "cluster config")
+}
+
+// renderDiff renders a colored diff of two messages as indented JSON
+func renderDiff(before, after proto.Message, what string) (string, error) {
+	render := func(m proto.Message) (string, error) {
+		out, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(m)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode %s: %v", what, err)
+		}
+
+		// protojson output is deliberately unstable, so re-indent it for a stable diff
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, out, "", "  "); err != nil {
+			return "", fmt.Errorf("failed to encode %s: %v", what, err)
+		}
+		return buf.String(), nil
+	}
+
+	a, err := render(before)
+	if err != nil {
+		return "", err
+	}
+	b, err := render(after)
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ColoredDiff(a, b), nil
+}
@@ -0,0 +1,26 @@
+package patch
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewPatchCmd creates the root patch command
+func NewPatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "patch",
+		Short: "Update specific fields of NStream AI platform resources",
+		Long:  `Update specific fields of various NStream AI platform resources like clusters, buckets, etc.`,
+	}
+
+	// Add all subcommands
+	cmd.AddCommand(
+		NewClusterCmd(),
+		NewBucketCmd(),
+		NewStreamGraphCmd(),
+		NewStreamFinetunerCmd(),
+		NewBaseModelCmd(),
+		NewEmbeddingModelCmd(),
+	)
+
+	return cmd
+}
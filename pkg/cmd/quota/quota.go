@@ -0,0 +1,78 @@
+package quota
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/billing"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/printer"
+	billingproto "github.com/nstreama-ai/nstream-ai-cli/proto/billing"
+	"github.com/spf13/cobra"
+)
+
+// quotaFormats are the output formats supported by the quota command
+var quotaFormats = []string{printer.FormatTable, printer.FormatJSON, printer.FormatYAML}
+
+// NewQuotaCmd creates the quota command
+func NewQuotaCmd() *cobra.Command {
+	var outputFormat string
+
+	cmd := &cobra.Command{
+		Use:   "quota",
+		Short: "Show resource limits and usage",
+		Long: `Show your organization's limits and current usage for clusters, buckets,
+stream graphs and model deployments.
+
+Create commands check these limits before prompting for anything.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := printer.ValidateFormat(outputFormat, quotaFormats...); err != nil {
+				return err
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			quotas, err := billing.NewOperationsWithClient(session.Client, session.Config).GetQuotas(ctx)
+			if err != nil {
+				return err
+			}
+
+			return printer.PrintList(os.Stdout, outputFormat, quotas, quotaPrintOptions())
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", printer.FormatTable, printer.FormatHelp(quotaFormats...))
+
+	return cmd
+}
+
+// quotaPrintOptions returns the printer options for quotas
+func quotaPrintOptions() printer.Options[*billingproto.Quota] {
+	return printer.Options[*billingproto.Quota]{
+		Kind: "quota",
+		Name: func(q *billingproto.Quota) string { return q.Resource },
+		Columns: []printer.Column[*billingproto.Quota]{
+			{Header: "RESOURCE", Value: func(q *billingproto.Quota) string { return billing.QuotaLabel(q.Resource) }},
+			{Header: "USED", Value: func(q *billingproto.Quota) string { return fmt.Sprint(q.Used) }},
+			{Header: "LIMIT", Value: func(q *billingproto.Quota) string {
+				if q.Limit == 0 {
+					return "unlimited"
+				}
+				return fmt.Sprint(q.Limit)
+			}},
+			{Header: "AVAILABLE", Value: func(q *billingproto.Quota) string {
+				if q.Limit == 0 {
+					return "-"
+				}
+				return fmt.Sprint(max(q.Limit-q.Used, 0))
+			}},
+		},
+	}
+}
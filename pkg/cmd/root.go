@@ -1,10 +1,22 @@
 package cmd
 
 import (
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/banner"
 	authcmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/auth"
+	billingcmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/billing"
+	bucketcmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/bucket"
+	clustercmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/cluster"
 	createcmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/create"
+	deletecmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/delete"
+	eventscmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/events"
+	getcmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/get"
 	initcmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/init"
+	labelcmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/label"
+	logscmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/logs"
+	patchcmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/patch"
+	quotacmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/quota"
+	topcmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/top"
 	usecmd "github.com/nstreama-ai/nstream-ai-cli/pkg/cmd/use"
 	"github.com/spf13/cobra"
 )
@@ -15,6 +27,8 @@ var rootCmd = &cobra.Command{
 	Use:   "nsai",
 	Short: "NStream AI CLI",
 	Long:  `A command line interface for NStream AI platform`,
+	// Every command is authenticated here unless it opts out with auth.SkipAuth
+	PersistentPreRunE: auth.PersistentPreRunE,
 }
 
 func init() {
@@ -33,10 +47,49 @@ func init() {
 	// Add create command
 	rootCmd.AddCommand(createcmd.NewCreateCmd())
 
+	// Add get command
+	rootCmd.AddCommand(getcmd.NewGetCmd())
+
+	// Add delete command
+	rootCmd.AddCommand(deletecmd.NewDeleteCmd())
+
+	// Add patch command
+	rootCmd.AddCommand(patchcmd.NewPatchCmd())
+
 	// Add use command
 	rootCmd.AddCommand(usecmd.NewUseCmd())
+
+	// Add label and annotate commands
+	rootCmd.AddCommand(labelcmd.NewLabelCmd())
+	rootCmd.AddCommand(labelcmd.NewAnnotateCmd())
+
+	// Add cluster lifecycle commands
+	rootCmd.AddCommand(clustercmd.NewClusterCmd())
+
+	// Add bucket object commands
+	rootCmd.AddCommand(bucketcmd.NewBucketCmd())
+
+	// Add events command
+	rootCmd.AddCommand(eventscmd.NewEventsCmd())
+
+	// Add logs command
+	rootCmd.AddCommand(logscmd.NewLogsCmd())
+
+	// Add top commands
+	rootCmd.AddCommand(topcmd.NewTopCmd())
+
+	// Add billing commands
+	rootCmd.AddCommand(billingcmd.NewBillingCmd())
+
+	// Add quota command
+	rootCmd.AddCommand(quotacmd.NewQuotaCmd())
 }
 
 func Execute() error {
-	return rootCmd.Execute()
+	// Close the session here rather than in a post-run hook, which cobra skips when RunE fails
+	cmd, err := rootCmd.ExecuteC()
+	if cmd != nil {
+		auth.CloseSession(cmd.Context())
+	}
+	return err
 }
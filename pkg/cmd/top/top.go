@@ -0,0 +1,206 @@
+package top
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
+	metricsops "github.com/nstreama-ai/nstream-ai-cli/pkg/metrics"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/printer"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
+	metricsproto "github.com/nstreama-ai/nstream-ai-cli/proto/metrics"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// topFormats are the output formats supported by top commands
+var topFormats = []string{printer.FormatTable, printer.FormatJSON}
+
+// sortKeys maps --sort-by values to a less function; numeric columns sort highest first
+var sortKeys = map[string]func(a, b *metricsproto.ResourceMetrics) bool{
+	"name":       func(a, b *metricsproto.ResourceMetrics) bool { return a.Name < b.Name },
+	"throughput": func(a, b *metricsproto.ResourceMetrics) bool { return a.Throughput > b.Throughput },
+	"latency":    func(a, b *metricsproto.ResourceMetrics) bool { return a.LatencyP99Ms > b.LatencyP99Ms },
+	"errors":     func(a, b *metricsproto.ResourceMetrics) bool { return a.ErrorRate > b.ErrorRate },
+	"cpu":        func(a, b *metricsproto.ResourceMetrics) bool { return a.CpuPercent > b.CpuPercent },
+	"memory":     func(a, b *metricsproto.ResourceMetrics) bool { return a.MemoryBytes > b.MemoryBytes },
+}
+
+// sortKeyNames lists the --sort-by values in the order they are documented
+var sortKeyNames = []string{"name", "throughput", "latency", "errors", "cpu", "memory"}
+
+// NewTopCmd creates the root top command
+func NewTopCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top",
+		Short: "Show live resource metrics",
+		Long:  `Show live throughput, latency, error-rate and resource usage of clusters and the resources running on them`,
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		newTopResourceCmd("cluster", metricsops.KindCluster, "clusters"),
+		newTopResourceCmd("streamgraph", metricsops.KindGraph, "stream graphs"),
+		newTopResourceCmd("connector", metricsops.KindConnector, "stream connectors"),
+		newTopResourceCmd("model", metricsops.KindModel, "models"),
+	)
+
+	return cmd
+}
+
+// newTopResourceCmd builds the top command for one resource kind
+func newTopResourceCmd(use, kind, plural string) *cobra.Command {
+	var (
+		clusterName  string
+		sortBy       string
+		interval     time.Duration
+		once         bool
+		outputFormat string
+	)
+
+	scope := "the current cluster context unless --cluster is given"
+	if kind == metricsops.KindCluster {
+		scope = "all clusters unless --cluster is given"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: "Show live metrics of " + plural,
+		Long: fmt.Sprintf(`Show live metrics of %s in %s.
+
+The table refreshes every --interval until interrupted. Use --once to print a
+single snapshot, e.g. 'nsai top %s --once -o json' for scripts.`, plural, scope, use),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := printer.ValidateFormat(outputFormat, topFormats...); err != nil {
+				return err
+			}
+			less, ok := sortKeys[sortBy]
+			if !ok {
+				return fmt.Errorf("invalid --sort-by value %q (must be one of %s)", sortBy, strings.Join(sortKeyNames, ", "))
+			}
+			if interval < time.Second {
+				return fmt.Errorf("--interval must be at least 1s")
+			}
+
+			session, err := auth.SessionFromContext(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			name := clusterName
+			if name == "" && kind != metricsops.KindCluster {
+				name = session.Config.Cluster.Name
+				if name == "" {
+					return fmt.Errorf("no cluster selected. Use --cluster or 'nsai use cluster'")
+				}
+			}
+
+			// Stop refreshing on Ctrl-C without reporting an error
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+
+			var renderErr error
+			refresh := !once && outputFormat == printer.FormatTable && utils.IsTerminal(os.Stdout)
+			render := func(snapshot *metricsproto.MetricsSnapshot) {
+				if renderErr != nil {
+					return
+				}
+				sort.SliceStable(snapshot.Resources, func(i, j int) bool {
+					return less(snapshot.Resources[i], snapshot.Resources[j])
+				})
+				renderErr = renderSnapshot(snapshot, kind, outputFormat, once, refresh, sortBy)
+			}
+
+			ops := metricsops.NewOperationsWithClient(session.Client, session.Config)
+			if err := ops.WatchMetrics(ctx, name, kind, interval, once, render); err != nil {
+				return err
+			}
+			return renderErr
+		},
+	}
+
+	cmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "Cluster name")
+	cmd.Flags().StringVar(&sortBy, "sort-by", "cpu", "Sort by column ("+strings.Join(sortKeyNames, ", ")+")")
+	cmd.Flags().DurationVar(&interval, "interval", 2*time.Second, "Time between refreshes")
+	cmd.Flags().BoolVar(&once, "once", false, "Print a single snapshot and exit")
+	cmd.Flags().StringVarP(&outputFormat, "output", "o", printer.FormatTable, printer.FormatHelp(topFormats...))
+
+	return cmd
+}
+
+// renderSnapshot prints one snapshot. Refreshing tables redraw the screen in place; JSON
+// is printed indented for a single snapshot and as one line per snapshot otherwise.
+func renderSnapshot(snapshot *metricsproto.MetricsSnapshot, kind, format string, once, refresh bool, sortBy string) error {
+	if format == printer.FormatJSON {
+		if once {
+			return printer.PrintItem(os.Stdout, format, snapshot, printer.Options[*metricsproto.MetricsSnapshot]{})
+		}
+
+		data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(snapshot)
+		if err != nil {
+			return fmt.Errorf("failed to encode output: %v", err)
+		}
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, data); err != nil {
+			return fmt.Errorf("failed to encode output: %v", err)
+		}
+		buf.WriteByte('\n')
+		_, err = os.Stdout.Write(buf.Bytes())
+		return err
+	}
+
+	if refresh {
+		// Move the cursor home and clear the screen before redrawing
+		fmt.Print("\033[H\033[2J")
+	}
+	if !once {
+		updated := time.Now()
+		if snapshot.Timestamp != nil {
+			updated = snapshot.Timestamp.AsTime()
+		}
+		fmt.Printf("%sUpdated %s, sorted by %s%s\n\n", utils.BoldColor, updated.Local().Format("15:04:05"), sortBy, utils.ResetColor)
+	}
+
+	if len(snapshot.Resources) == 0 {
+		fmt.Println("No resources found.")
+	} else if err := printer.PrintList(os.Stdout, format, snapshot.Resources, metricsPrintOptions(kind)); err != nil {
+		return err
+	}
+	if !once && !refresh {
+		fmt.Println()
+	}
+	return nil
+}
+
+// metricsPrintOptions returns the printer options for resource metrics
+func metricsPrintOptions(kind string) printer.Options[*metricsproto.ResourceMetrics] {
+	var columns []printer.Column[*metricsproto.ResourceMetrics]
+	if kind != metricsops.KindCluster {
+		columns = append(columns, printer.Column[*metricsproto.ResourceMetrics]{
+			Header: "CLUSTER", Value: func(m *metricsproto.ResourceMetrics) string { return m.ClusterName },
+		})
+	}
+
+	columns = append(columns,
+		printer.Column[*metricsproto.ResourceMetrics]{Header: "NAME", Value: func(m *metricsproto.ResourceMetrics) string { return m.Name }},
+		printer.Column[*metricsproto.ResourceMetrics]{Header: "THROUGHPUT", Value: func(m *metricsproto.ResourceMetrics) string { return fmt.Sprintf("%.1f/s", m.Throughput) }},
+		printer.Column[*metricsproto.ResourceMetrics]{Header: "P50", Value: func(m *metricsproto.ResourceMetrics) string { return fmt.Sprintf("%.1fms", m.LatencyP50Ms) }},
+		printer.Column[*metricsproto.ResourceMetrics]{Header: "P99", Value: func(m *metricsproto.ResourceMetrics) string { return fmt.Sprintf("%.1fms", m.LatencyP99Ms) }},
+		printer.Column[*metricsproto.ResourceMetrics]{Header: "ERRORS", Value: func(m *metricsproto.ResourceMetrics) string { return fmt.Sprintf("%.2f%%", m.ErrorRate*100) }},
+		printer.Column[*metricsproto.ResourceMetrics]{Header: "CPU", Value: func(m *metricsproto.ResourceMetrics) string { return fmt.Sprintf("%.0f%%", m.CpuPercent) }},
+		printer.Column[*metricsproto.ResourceMetrics]{Header: "MEMORY", Value: func(m *metricsproto.ResourceMetrics) string { return utils.FormatBytes(m.MemoryBytes) }},
+	)
+
+	return printer.Options[*metricsproto.ResourceMetrics]{
+		Kind:    kind,
+		Name:    func(m *metricsproto.ResourceMetrics) string { return m.Name },
+		Columns: columns,
+	}
+}
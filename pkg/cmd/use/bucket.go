@@ -1,18 +1,19 @@
 package use
 
 import (
-	"context"
 	"fmt"
 	"os"
-	"path/filepath"
 	"strconv"
+	"strings"
 	"text/tabwriter"
 
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/banner"
-	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	bucketops "github.com/nstreama-ai/nstream-ai-cli/pkg/bucket"
+	clusterops "github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/labels"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
-	authproto "github.com/nstreama-ai/nstream-ai-cli/proto/auth"
 	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
 	"github.com/spf13/cobra"
 )
@@ -21,6 +22,8 @@ var (
 	bucketUseName     string
 	bucketRoleName    string
 	bucketClusterName string
+	bucketSelector    string
+	bucketPurpose     string
 )
 
 // NewBucketCmd creates the bucket use command
@@ -30,8 +33,10 @@ func NewBucketCmd() *cobra.Command {
 		Short: "Use a specific bucket in a cluster",
 		Long: `Set the current bucket context for operations within a cluster.
 
-If bucket name is provided as an argument, it will be used directly.
-Otherwise, you'll be prompted to select from available buckets.
+Only buckets attached to the cluster ('nsai cluster bucket attach') can be used.
+If a bucket name or --purpose is provided, the matching attached bucket is used
+directly. Otherwise, you'll be prompted to select from the attached buckets. Use
+--selector to only offer buckets whose labels match.
 
 You must have a cluster context set or provide a cluster name to use this command.`,
 		Args: cobra.MaximumNArgs(1),
@@ -41,73 +46,21 @@ You must have a cluster context set or provide a cluster name to use this comman
 			fmt.Println("Setting up bucket context...")
 			fmt.Println()
 
-			// Check if config exists
-			configPath := filepath.Join(os.Getenv("HOME"), ".nstreamconfig")
-			if _, err := os.Stat(configPath); os.IsNotExist(err) {
-				fmt.Println("No configuration found. You need to authenticate first.")
-				fmt.Println("\nPlease choose one of the following options:")
-				fmt.Println("1. Sign in to an existing account: 'nsai auth signin'")
-				fmt.Println("2. Create a new account: 'nsai auth signup'")
-				fmt.Println("\nAfter authentication, run 'nsai use bucket' again")
-				return fmt.Errorf("authentication required")
-			}
-
-			// Load config to check user credentials
-			cfg, err := config.LoadConfig()
+			sel, err := labels.ParseSelector(bucketSelector)
 			if err != nil {
-				return fmt.Errorf("failed to load config: %v", err)
-			}
-
-			// Check if user is authenticated
-			if cfg.User.AuthToken == "" {
-				fmt.Println("No authentication token found. You need to sign in first.")
-				fmt.Println("\nRun 'nsai auth signin' to authenticate")
-				fmt.Println("After authentication, run 'nsai use bucket' again")
-				return fmt.Errorf("authentication required")
+				return err
 			}
 
-			// Create gRPC client
-			c, err := client.NewClient("", true, "")
+			session, err := auth.SessionFromContext(cmd.Context())
 			if err != nil {
-				return err // Return the original error message without wrapping
+				return err
 			}
-			defer c.Close()
+			c, cfg := session.Client, session.Config
 
 			// Create context
-			ctx, cancel := c.WithContext(context.Background())
+			ctx, cancel := c.WithContext(cmd.Context())
 			defer cancel()
 
-			// Validate user
-			validateResp, err := c.AuthClient.ValidateUser(ctx, &authproto.ValidateUserRequest{
-				Email: cfg.User.Email,
-			})
-			if err != nil {
-				return fmt.Errorf("failed to validate user: %v", err)
-			}
-
-			if !validateResp.Valid {
-				fmt.Println("User validation failed. Please authenticate first:")
-				fmt.Println("1. Sign in: 'nsai auth signin'")
-				fmt.Println("2. Sign up: 'nsai auth signup'")
-				return fmt.Errorf("authentication required")
-			}
-
-			// Validate token
-			tokenResp, err := c.AuthClient.ValidateToken(ctx, &authproto.ValidateTokenRequest{
-				Token: cfg.User.AuthToken,
-			})
-			if err != nil {
-				return fmt.Errorf("error validating token: %v", err)
-			}
-
-			if !tokenResp.Valid {
-				fmt.Printf("Authentication token is invalid: %s\n", tokenResp.Error)
-				fmt.Println("\nPlease authenticate first:")
-				fmt.Println("1. Sign in: 'nsai auth signin'")
-				fmt.Println("2. Sign up: 'nsai auth signup'")
-				return fmt.Errorf("authentication required")
-			}
-
 			// Get cluster name from flag or config
 			var clusterName string
 			if bucketClusterName != "" {
@@ -118,17 +71,15 @@ You must have a cluster context set or provide a cluster name to use this comman
 				go utils.ShowDefaultLoading("Fetching available clusters", done)
 
 				// List clusters
-				listResp, err := c.ClusterClient.ListClusters(ctx, &clusterproto.ListClustersRequest{
-					AuthToken: cfg.User.AuthToken,
-				})
+				clusters, err := clusterops.NewOperationsWithClient(c, cfg).ListClusters(ctx, nil)
 				if err != nil {
 					done <- true
-					return fmt.Errorf("failed to get clusters: %v", err)
+					return err
 				}
 
 				done <- true
 
-				if len(listResp.Clusters) == 0 {
+				if len(clusters) == 0 {
 					fmt.Println("\nNo clusters available.")
 					fmt.Println("Please create a cluster first using 'nsai create cluster'")
 					return fmt.Errorf("no clusters available")
@@ -138,7 +89,7 @@ You must have a cluster context set or provide a cluster name to use this comman
 				fmt.Println("\nAvailable clusters:")
 				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 				fmt.Fprintln(w, "ID\tRegion\tCloud\tBucket\tIdentity")
-				for i, cluster := range listResp.Clusters {
+				for i, cluster := range clusters {
 					fmt.Fprintf(w, "%d. %s\t%s\t%s\t%s\t%s\n",
 						i+1,
 						cluster.Id,
@@ -160,10 +111,10 @@ You must have a cluster context set or provide a cluster name to use this comman
 				} else {
 					// Convert choice to integer
 					choiceInt, err := strconv.Atoi(choice)
-					if err != nil || choiceInt < 1 || choiceInt > len(listResp.Clusters) {
+					if err != nil || choiceInt < 1 || choiceInt > len(clusters) {
 						return fmt.Errorf("invalid cluster choice")
 					}
-					clusterName = listResp.Clusters[choiceInt-1].Id
+					clusterName = clusters[choiceInt-1].Id
 				}
 			} else {
 				// Create a channel for loading animation
@@ -171,17 +122,15 @@ You must have a cluster context set or provide a cluster name to use this comman
 				go utils.ShowDefaultLoading("Fetching available clusters", done)
 
 				// List clusters
-				listResp, err := c.ClusterClient.ListClusters(ctx, &clusterproto.ListClustersRequest{
-					AuthToken: cfg.User.AuthToken,
-				})
+				clusters, err := clusterops.NewOperationsWithClient(c, cfg).ListClusters(ctx, nil)
 				if err != nil {
 					done <- true
-					return fmt.Errorf("failed to get clusters: %v", err)
+					return err
 				}
 
 				done <- true
 
-				if len(listResp.Clusters) == 0 {
+				if len(clusters) == 0 {
 					fmt.Println("\nNo clusters available.")
 					fmt.Println("Please create a cluster first using 'nsai create cluster'")
 					return fmt.Errorf("no clusters available")
@@ -191,7 +140,7 @@ You must have a cluster context set or provide a cluster name to use this comman
 				fmt.Println("\nAvailable clusters:")
 				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 				fmt.Fprintln(w, "ID\tRegion\tCloud\tBucket\tIdentity")
-				for i, cluster := range listResp.Clusters {
+				for i, cluster := range clusters {
 					fmt.Fprintf(w, "%d. %s\t%s\t%s\t%s\t%s\n",
 						i+1,
 						cluster.Id,
@@ -207,11 +156,11 @@ You must have a cluster context set or provide a cluster name to use this comman
 				var choice int
 				fmt.Scanf("%d", &choice)
 
-				if choice < 1 || choice > len(listResp.Clusters) {
+				if choice < 1 || choice > len(clusters) {
 					return fmt.Errorf("invalid cluster choice")
 				}
 
-				clusterName = listResp.Clusters[choice-1].Id
+				clusterName = clusters[choice-1].Id
 			}
 
 			// Get bucket name from args or flag
@@ -222,57 +171,72 @@ You must have a cluster context set or provide a cluster name to use this comman
 				bucketName = bucketUseName
 			}
 
-			// If no bucket name is provided, list buckets and let user choose
-			if bucketName == "" {
-				// Create a channel for loading animation
-				done := make(chan bool)
-				go utils.ShowDefaultLoading("Fetching available buckets", done)
-
-				// Get cluster details to check cloud provider
-				detailsResp, err := c.ClusterClient.GetClusterDetails(ctx, &clusterproto.GetClusterDetailsRequest{
-					ClusterName: clusterName,
-					AuthToken:   cfg.User.AuthToken,
-				})
-				if err != nil {
-					done <- true
-					return fmt.Errorf("failed to get cluster details: %v", err)
+			if bucketPurpose != "" {
+				if err := clusterops.ValidatePurpose(bucketPurpose); err != nil {
+					return err
 				}
+			}
 
-				if detailsResp.Error != "" {
-					done <- true
-					return fmt.Errorf("failed to get cluster details: %s", detailsResp.Error)
-				}
+			// Create a channel for loading animation
+			done := make(chan bool)
+			go utils.ShowDefaultLoading("Fetching attached buckets", done)
+
+			// Get cluster details for its cloud provider and default role
+			ops := clusterops.NewOperationsWithClient(c, cfg)
+			details, err := ops.GetClusterDetails(ctx, clusterName)
+			if err != nil {
+				done <- true
+				return err
+			}
+
+			// Only buckets attached to the cluster can be used
+			attachments, err := ops.ListAttachedBuckets(ctx, clusterName)
+			if err != nil {
+				done <- true
+				return err
+			}
 
-				// List buckets
-				bucketsResp, err := c.BucketClient.ListBuckets(ctx, &clusterproto.ListBucketsRequest{
-					CloudProvider: detailsResp.Config.CloudProvider,
-					AuthToken:     cfg.User.AuthToken,
-				})
+			if sel != nil {
+				buckets, err := bucketops.NewOperationsWithClient(c, cfg).ListBuckets(ctx, details.CloudProvider, sel)
 				if err != nil {
 					done <- true
-					return fmt.Errorf("failed to get buckets: %v", err)
+					return err
 				}
+				attachments = filterAttachments(attachments, buckets)
+			}
 
-				done <- true
+			done <- true
 
-				if len(bucketsResp.Buckets) == 0 {
-					fmt.Println("\nNo buckets available.")
-					fmt.Println("Please create a bucket first using 'nsai create bucket'")
-					return fmt.Errorf("no buckets available")
+			var candidates []*clusterproto.BucketAttachment
+			for _, a := range attachments {
+				if (bucketName == "" || a.Bucket == bucketName) && (bucketPurpose == "" || a.Purpose == bucketPurpose) {
+					candidates = append(candidates, a)
 				}
+			}
 
-				// Display buckets in a table
-				fmt.Println("\nAvailable buckets:")
+			var attachment *clusterproto.BucketAttachment
+			switch {
+			case len(candidates) == 0 && bucketName != "":
+				return fmt.Errorf("bucket '%s' is not attached to cluster '%s'. Attach it with 'nsai cluster bucket attach %s --bucket %s --purpose <purpose>'",
+					bucketName, clusterName, clusterName, bucketName)
+			case len(candidates) == 0:
+				fmt.Printf("\nNo buckets attached to cluster '%s'.\n", clusterName)
+				fmt.Println("Please attach a bucket first using 'nsai cluster bucket attach'")
+				return fmt.Errorf("no buckets available")
+			case bucketName != "" || bucketPurpose != "":
+				// A bucket attached for several purposes uses the same bucket context
+				attachment = candidates[0]
+			default:
+				// Display attached buckets in a table
+				fmt.Println("\nAttached buckets:")
 				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-				fmt.Fprintln(w, "ID\tName\tRegion\tProvider\tSize\tCreated At")
-				for i, bucket := range bucketsResp.Buckets {
-					fmt.Fprintf(w, "%d. %s\t%s\t%s\t%s\t%s\n",
+				fmt.Fprintln(w, "ID\tPurpose\tName\tIdentity")
+				for i, a := range candidates {
+					fmt.Fprintf(w, "%d. %s\t%s\t%s\n",
 						i+1,
-						bucket.Name,
-						bucket.Region,
-						bucket.Provider,
-						bucket.Size,
-						bucket.CreatedAt,
+						a.Purpose,
+						a.Bucket,
+						a.Role,
 					)
 				}
 				w.Flush()
@@ -281,67 +245,51 @@ You must have a cluster context set or provide a cluster name to use this comman
 				var choice int
 				fmt.Scanf("%d", &choice)
 
-				if choice < 1 || choice > len(bucketsResp.Buckets) {
+				if choice < 1 || choice > len(candidates) {
 					return fmt.Errorf("invalid bucket choice")
 				}
 
-				bucketName = bucketsResp.Buckets[choice-1].Name
+				attachment = candidates[choice-1]
+			}
 
-				// Check if cloud providers match
-				if detailsResp.Config.CloudProvider != bucketsResp.Buckets[choice-1].Provider {
-					return fmt.Errorf("cloud provider mismatch: cluster '%s' uses '%s' but bucket '%s' uses '%s'",
-						clusterName,
-						detailsResp.Config.CloudProvider,
-						bucketName,
-						bucketsResp.Buckets[choice-1].Provider,
-					)
-				}
+			bucketName = attachment.Bucket
+			role := attachment.Role
+			if role == "" {
+				role = details.Role
 			}
 
 			// Verify bucket access
-			done := make(chan bool)
+			done = make(chan bool)
 			go utils.ShowDefaultLoading("Verifying bucket access", done)
 
-			accessResp, err := c.BucketClient.VerifyBucketAccess(ctx, &clusterproto.VerifyBucketAccessRequest{
-				CloudProvider: cfg.Cluster.CloudProvider,
-				Bucket:        bucketName,
-				Role:          cfg.Cluster.Role,
-				AuthToken:     cfg.User.AuthToken,
-			})
+			accessResp, err := bucketops.NewOperationsWithClient(c, cfg).VerifyAccess(ctx, details.CloudProvider, bucketName, role)
+			done <- true
 			if err != nil {
-				done <- true
-				return fmt.Errorf("failed to verify bucket access: %v", err)
+				return err
 			}
 
 			if !accessResp.HasAccess {
-				done <- true
-				return fmt.Errorf("bucket access verification failed: %s", accessResp.Error)
+				fmt.Println()
+				bucketops.WriteAccessChecks(os.Stdout, details.CloudProvider, bucketName, role, accessResp)
+				return fmt.Errorf("bucket access verification failed")
 			}
 
-			done <- true
-
 			// Check resource readiness
 			done = make(chan bool)
 			go utils.ShowDefaultLoading("Checking resource readiness", done)
 
-			readyResp, err := c.BucketClient.CheckResourceReadiness(ctx, &clusterproto.CheckResourceReadinessRequest{
-				CloudProvider: cfg.Cluster.CloudProvider,
-				Bucket:        bucketName,
-				Role:          cfg.Cluster.Role,
-				AuthToken:     cfg.User.AuthToken,
-			})
+			readyResp, err := bucketops.NewOperationsWithClient(c, cfg).CheckReadiness(ctx, details.CloudProvider, bucketName, role, "")
+			done <- true
 			if err != nil {
-				done <- true
-				return fmt.Errorf("failed to check resource readiness: %v", err)
+				return err
 			}
 
 			if !readyResp.Ready {
-				done <- true
+				fmt.Println()
+				bucketops.WriteReadinessChecks(os.Stdout, details.CloudProvider, bucketName, role, readyResp)
 				return fmt.Errorf("resources not ready: %s", readyResp.Error)
 			}
 
-			done <- true
-
 			// Update config with bucket details
 			cfg.Cluster.Bucket = bucketName
 			if err := config.SaveConfig(cfg); err != nil {
@@ -351,10 +299,11 @@ You must have a cluster context set or provide a cluster name to use this comman
 			fmt.Printf("\r%s%s✓ Successfully set bucket context%s\n", utils.BoldColor, utils.RedColor, utils.ResetColor)
 			fmt.Printf("\n%sBucket Details:%s\n", utils.BoldColor, utils.ResetColor)
 			fmt.Printf("  Name: %s\n", bucketName)
+			fmt.Printf("  Purpose: %s\n", attachment.Purpose)
 			fmt.Printf("  Cluster: %s\n", clusterName)
-			fmt.Printf("  Cloud Provider: %s\n", cfg.Cluster.CloudProvider)
-			fmt.Printf("  Region: %s\n", cfg.Cluster.Region)
-			fmt.Printf("  Identity: %s\n", cfg.Cluster.Role)
+			fmt.Printf("  Cloud Provider: %s\n", details.CloudProvider)
+			fmt.Printf("  Region: %s\n", details.Region)
+			fmt.Printf("  Identity: %s\n", role)
 			fmt.Printf("\n%sYou can now use this bucket for operations.%s\n", utils.BoldColor, utils.ResetColor)
 			return nil
 		},
@@ -362,6 +311,8 @@ You must have a cluster context set or provide a cluster name to use this comman
 
 	cmd.Flags().StringVarP(&bucketUseName, "name", "n", "", "Bucket name (optional, will prompt for selection if not provided)")
 	cmd.Flags().StringVarP(&bucketClusterName, "cluster", "c", "", "Cluster name (optional, will use current cluster if not provided)")
+	cmd.Flags().StringVarP(&bucketSelector, "selector", "l", "", "Label selector to filter the buckets offered (e.g. env=prod)")
+	cmd.Flags().StringVar(&bucketPurpose, "purpose", "", "Use the bucket attached for this purpose ("+strings.Join(clusterops.BucketPurposes, ", ")+")")
 
 	// Role subcommand
 	roleCmd := &cobra.Command{
@@ -378,3 +329,19 @@ You must have a cluster context set or provide a cluster name to use this comman
 	cmd.AddCommand(roleCmd)
 	return cmd
 }
+
+// filterAttachments keeps the attachments whose bucket is in buckets
+func filterAttachments(attachments []*clusterproto.BucketAttachment, buckets []*clusterproto.Bucket) []*clusterproto.BucketAttachment {
+	names := make(map[string]bool, len(buckets))
+	for _, b := range buckets {
+		names[b.Name] = true
+	}
+
+	var filtered []*clusterproto.BucketAttachment
+	for _, a := range attachments {
+		if names[a.Bucket] {
+			filtered = append(filtered, a)
+		}
+	}
+	return filtered
+}
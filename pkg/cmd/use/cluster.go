@@ -1,17 +1,20 @@
 package use
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/auth"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/banner"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/cluster"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/labels"
 	"github.com/nstreama-ai/nstream-ai-cli/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
-var useClusterName string
+var (
+	useClusterName     string
+	useClusterSelector string
+)
 
 // NewClusterCmd creates the cluster use command
 func NewClusterCmd() *cobra.Command {
@@ -21,7 +24,8 @@ func NewClusterCmd() *cobra.Command {
 		Long: `Set the current cluster context for operations.
 
 If cluster name is provided as an argument, it will be used directly.
-Otherwise, you'll be prompted to select from available clusters.`,
+Otherwise, you'll be prompted to select from available clusters. Use --selector
+to only offer clusters whose labels match.`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Print banner
@@ -29,29 +33,21 @@ Otherwise, you'll be prompted to select from available clusters.`,
 			fmt.Println("Setting up cluster context...")
 			fmt.Println()
 
-			// Create validator and validate authentication
-			validator, err := auth.NewValidator()
+			sel, err := labels.ParseSelector(useClusterSelector)
 			if err != nil {
-				return fmt.Errorf("failed to create validator: %v", err)
-			}
-			defer validator.Close()
-
-			ctx := context.Background()
-			if err := validator.ValidateAll(ctx); err != nil {
-				fmt.Println("\nAuthentication failed:")
-				fmt.Printf("Error: %v\n", err)
-				fmt.Println("\nPlease try the following:")
-				fmt.Println("1. Sign in again: 'nsai auth signin'")
-				fmt.Println("2. If that doesn't work, sign up: 'nsai auth signup'")
-				return fmt.Errorf(utils.ErrAuthRequired)
+				return err
 			}
 
-			// Create cluster operations
-			ops, err := cluster.NewOperations()
+			session, err := auth.SessionFromContext(cmd.Context())
 			if err != nil {
-				return fmt.Errorf("failed to create cluster operations: %v", err)
+				return err
 			}
-			defer ops.Close()
+
+			ctx, cancel := session.Client.WithContext(cmd.Context())
+			defer cancel()
+
+			// Create cluster operations
+			ops := cluster.NewOperationsWithClient(session.Client, session.Config)
 
 			// Get cluster name from args or flag
 			var clusterName string
@@ -98,7 +94,7 @@ Otherwise, you'll be prompted to select from available clusters.`,
 			go utils.ShowDefaultLoading("Fetching available clusters", done)
 
 			// List clusters
-			clusters, err := ops.ListClusters(ctx)
+			clusters, err := ops.ListClusters(ctx, sel)
 			if err != nil {
 				done <- true
 				return err
@@ -156,5 +152,6 @@ Otherwise, you'll be prompted to select from available clusters.`,
 	}
 
 	cmd.Flags().StringVarP(&useClusterName, "name", "n", "", "Cluster name (optional, will prompt for selection if not provided)")
+	cmd.Flags().StringVarP(&useClusterSelector, "selector", "l", "", "Label selector to filter the clusters offered (e.g. env=prod)")
 	return cmd
 }
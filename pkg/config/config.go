@@ -31,6 +31,11 @@ func GetConfigPath() string {
 	return filepath.Join(os.Getenv("HOME"), ".nstreamconfig")
 }
 
+// GetCacheDir returns the directory used for short-lived local caches
+func GetCacheDir() string {
+	return filepath.Join(os.Getenv("HOME"), ".nstream", "cache")
+}
+
 func LoadConfig() (*Config, error) {
 	configPath := GetConfigPath()
 	data, err := os.ReadFile(configPath)
@@ -0,0 +1,348 @@
+package jsonpatch
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// jsonEqual reports whether a and b are the same JSON value
+func jsonEqual(t *testing.T, a, b string) bool {
+	t.Helper()
+
+	var va, vb interface{}
+	if err := json.Unmarshal([]byte(a), &va); err != nil {
+		t.Fatalf("invalid JSON %s: %v", a, err)
+	}
+	if err := json.Unmarshal([]byte(b), &vb); err != nil {
+		t.Fatalf("invalid JSON %s: %v", b, err)
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// TestMergePatch covers the examples of RFC 7386 appendix A
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		doc, patch, want string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+
+	for _, tt := range tests {
+		got, err := MergePatch([]byte(tt.doc), []byte(tt.patch))
+		if err != nil {
+			t.Errorf("MergePatch(%s, %s) error = %v", tt.doc, tt.patch, err)
+			continue
+		}
+		if !jsonEqual(t, string(got), tt.want) {
+			t.Errorf("MergePatch(%s, %s) = %s, want %s", tt.doc, tt.patch, got, tt.want)
+		}
+	}
+}
+
+func TestMergePatchInvalid(t *testing.T) {
+	if _, err := MergePatch([]byte(`{`), []byte(`{}`)); err == nil || !strings.Contains(err.Error(), "invalid document") {
+		t.Errorf("MergePatch() of an invalid document error = %v", err)
+	}
+	if _, err := MergePatch([]byte(`{}`), []byte(`{"a":`)); err == nil || !strings.Contains(err.Error(), "invalid merge patch") {
+		t.Errorf("MergePatch() with an invalid patch error = %v", err)
+	}
+}
+
+// TestApply covers the examples of RFC 6902 appendix A that succeed
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name, doc, patch, want string
+	}{
+		{
+			name:  "A.1 adding an object member",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"/baz","value":"qux"}]`,
+			want:  `{"baz":"qux","foo":"bar"}`,
+		},
+		{
+			name:  "A.2 adding an array element",
+			doc:   `{"foo":["bar","baz"]}`,
+			patch: `[{"op":"add","path":"/foo/1","value":"qux"}]`,
+			want:  `{"foo":["bar","qux","baz"]}`,
+		},
+		{
+			name:  "A.3 removing an object member",
+			doc:   `{"baz":"qux","foo":"bar"}`,
+			patch: `[{"op":"remove","path":"/baz"}]`,
+			want:  `{"foo":"bar"}`,
+		},
+		{
+			name:  "A.4 removing an array element",
+			doc:   `{"foo":["bar","qux","baz"]}`,
+			patch: `[{"op":"remove","path":"/foo/1"}]`,
+			want:  `{"foo":["bar","baz"]}`,
+		},
+		{
+			name:  "A.5 replacing a value",
+			doc:   `{"baz":"qux","foo":"bar"}`,
+			patch: `[{"op":"replace","path":"/baz","value":"boo"}]`,
+			want:  `{"baz":"boo","foo":"bar"}`,
+		},
+		{
+			name:  "A.6 moving a value",
+			doc:   `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			patch: `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			want:  `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`,
+		},
+		{
+			name:  "A.7 moving an array element",
+			doc:   `{"foo":["all","grass","cows","eat"]}`,
+			patch: `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`,
+			want:  `{"foo":["all","cows","eat","grass"]}`,
+		},
+		{
+			name:  "A.8 testing a value: success",
+			doc:   `{"baz":"qux","foo":["a",2,"c"]}`,
+			patch: `[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`,
+			want:  `{"baz":"qux","foo":["a",2,"c"]}`,
+		},
+		{
+			name:  "A.10 adding a nested member object",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`,
+			want:  `{"foo":"bar","child":{"grandchild":{}}}`,
+		},
+		{
+			name:  "A.11 ignoring unrecognized elements",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"/baz","value":"qux","xyz":123}]`,
+			want:  `{"foo":"bar","baz":"qux"}`,
+		},
+		{
+			name:  "A.14 ~ escape ordering",
+			doc:   `{"/":9,"~1":10}`,
+			patch: `[{"op":"test","path":"/~01","value":10}]`,
+			want:  `{"/":9,"~1":10}`,
+		},
+		{
+			name:  "A.16 adding an array value",
+			doc:   `{"foo":["bar"]}`,
+			patch: `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`,
+			want:  `{"foo":["bar",["abc","def"]]}`,
+		},
+		{
+			name:  "adding a null value",
+			doc:   `{"foo":"bar"}`,
+			patch: `[{"op":"add","path":"/baz","value":null}]`,
+			want:  `{"foo":"bar","baz":null}`,
+		},
+		{
+			name:  "replacing a value with null",
+			doc:   `{"foo":["bar"]}`,
+			patch: `[{"op":"replace","path":"/foo","value":null}]`,
+			want:  `{"foo":null}`,
+		},
+		{
+			name:  "testing a null value",
+			doc:   `{"foo":null}`,
+			patch: `[{"op":"test","path":"/foo","value":null}]`,
+			want:  `{"foo":null}`,
+		},
+		{
+			name:  "escaped slash",
+			doc:   `{"a/b":1}`,
+			patch: `[{"op":"replace","path":"/a~1b","value":2}]`,
+			want:  `{"a/b":2}`,
+		},
+		{
+			name:  "escaped tilde",
+			doc:   `{"m~n":1}`,
+			patch: `[{"op":"copy","from":"/m~0n","path":"/copy"}]`,
+			want:  `{"m~n":1,"copy":1}`,
+		},
+		{
+			name:  "appending to a nested array",
+			doc:   `{"spec":{"tags":["a"]}}`,
+			patch: `[{"op":"add","path":"/spec/tags/-","value":"b"},{"op":"add","path":"/spec/tags/-","value":"c"}]`,
+			want:  `{"spec":{"tags":["a","b","c"]}}`,
+		},
+		{
+			name:  "copies are independent",
+			doc:   `{"a":{"b":1}}`,
+			patch: `[{"op":"copy","from":"/a","path":"/c"},{"op":"replace","path":"/c/b","value":2}]`,
+			want:  `{"a":{"b":1},"c":{"b":2}}`,
+		},
+		{
+			name:  "replacing the whole document",
+			doc:   `{"a":1}`,
+			patch: `[{"op":"replace","path":"","value":[1]}]`,
+			want:  `[1]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Apply([]byte(tt.doc), []byte(tt.patch))
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if !jsonEqual(t, string(got), tt.want) {
+				t.Errorf("Apply() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestApplyErrors covers the failing examples of RFC 6902 appendix A and invalid pointers
+func TestApplyErrors(t *testing.T) {
+	tests := []struct {
+		name, doc, patch, wantErr string
+	}{
+		{
+			name:    "A.9 testing a value: error",
+			doc:     `{"baz":"qux"}`,
+			patch:   `[{"op":"test","path":"/baz","value":"bar"}]`,
+			wantErr: "test failed",
+		},
+		{
+			name:    "A.12 adding to a nonexistent target",
+			doc:     `{"foo":"bar"}`,
+			patch:   `[{"op":"add","path":"/baz/bat","value":"qux"}]`,
+			wantErr: `path "/baz" does not exist`,
+		},
+		{
+			name:    "A.15 comparing strings and numbers",
+			doc:     `{"/":9,"~1":10}`,
+			patch:   `[{"op":"test","path":"/~01","value":"10"}]`,
+			wantErr: "test failed",
+		},
+		{
+			name:    "add without a value",
+			doc:     `{"foo":"bar"}`,
+			patch:   `[{"op":"add","path":"/baz"}]`,
+			wantErr: "missing value",
+		},
+		{
+			name:    "replace without a value",
+			doc:     `{"foo":"bar"}`,
+			patch:   `[{"op":"replace","path":"/foo"}]`,
+			wantErr: "missing value",
+		},
+		{
+			name:    "test without a value",
+			doc:     `{"foo":null}`,
+			patch:   `[{"op":"test","path":"/foo"}]`,
+			wantErr: "missing value",
+		},
+		{
+			name:    "test of null against a value",
+			doc:     `{"foo":null}`,
+			patch:   `[{"op":"test","path":"/foo","value":0}]`,
+			wantErr: "test failed",
+		},
+		{
+			name:    "test of a missing value",
+			doc:     `{"a":1}`,
+			patch:   `[{"op":"test","path":"/b","value":1}]`,
+			wantErr: `path "/b" does not exist`,
+		},
+		{
+			name:    "pointer without a leading slash",
+			doc:     `{"a":1}`,
+			patch:   `[{"op":"replace","path":"a","value":2}]`,
+			wantErr: `invalid JSON pointer "a"`,
+		},
+		{
+			name:    "unescaped slash splits the key",
+			doc:     `{"a/b":1}`,
+			patch:   `[{"op":"remove","path":"/a/b"}]`,
+			wantErr: `path "/a" does not exist`,
+		},
+		{
+			name:    "array index past the end",
+			doc:     `{"a":[1,2]}`,
+			patch:   `[{"op":"add","path":"/a/3","value":3}]`,
+			wantErr: "array index 3 out of range",
+		},
+		{
+			name:    "array index with a leading zero",
+			doc:     `{"a":[1,2]}`,
+			patch:   `[{"op":"replace","path":"/a/01","value":3}]`,
+			wantErr: `invalid array index "01"`,
+		},
+		{
+			name:    "array index with a sign",
+			doc:     `{"a":[1,2]}`,
+			patch:   `[{"op":"remove","path":"/a/+1"}]`,
+			wantErr: `invalid array index "+1"`,
+		},
+		{
+			name:    "negative array index",
+			doc:     `{"a":[1,2]}`,
+			patch:   `[{"op":"remove","path":"/a/-1"}]`,
+			wantErr: `invalid array index "-1"`,
+		},
+		{
+			name:    "end of array only names a new element",
+			doc:     `{"a":[1,2]}`,
+			patch:   `[{"op":"remove","path":"/a/-"}]`,
+			wantErr: `invalid array index "-"`,
+		},
+		{
+			name:    "end of array cannot be replaced",
+			doc:     `{"a":[1,2]}`,
+			patch:   `[{"op":"replace","path":"/a/-","value":3}]`,
+			wantErr: `invalid array index "-"`,
+		},
+		{
+			name:    "move into a child",
+			doc:     `{"a":{"b":1}}`,
+			patch:   `[{"op":"move","from":"/a","path":"/a/c"}]`,
+			wantErr: "cannot move a value into one of its children",
+		},
+		{
+			name:    "remove a missing member",
+			doc:     `{"a":1}`,
+			patch:   `[{"op":"remove","path":"/b"}]`,
+			wantErr: `path "/b" does not exist`,
+		},
+		{
+			name:    "unsupported operation",
+			doc:     `{}`,
+			patch:   `[{"op":"merge","path":"/a"}]`,
+			wantErr: `unsupported operation "merge"`,
+		},
+		{
+			name:    "failed operations are numbered",
+			doc:     `{"a":1}`,
+			patch:   `[{"op":"test","path":"/a","value":1},{"op":"remove","path":"/b"}]`,
+			wantErr: "operation 1 (remove /b)",
+		},
+		{
+			name:    "patch is not an array",
+			doc:     `{}`,
+			patch:   `{"op":"add","path":"/a","value":1}`,
+			wantErr: "invalid JSON patch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Apply([]byte(tt.doc), []byte(tt.patch))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Apply() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
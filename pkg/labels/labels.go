@@ -0,0 +1,101 @@
+package labels
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	maxNameLength   = 63
+	maxPrefixLength = 253
+)
+
+var (
+	namePattern   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	prefixPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// ValidateKey checks a label or annotation key: an optional DNS-style prefix and a slash,
+// followed by a name of at most 63 alphanumerics, '-', '_' or '.'
+func ValidateKey(key string) error {
+	name := key
+	if prefix, rest, ok := strings.Cut(key, "/"); ok {
+		if len(prefix) > maxPrefixLength || !prefixPattern.MatchString(prefix) {
+			return fmt.Errorf("invalid key %q: prefix must be a lowercase DNS subdomain", key)
+		}
+		name = rest
+	}
+
+	if len(name) > maxNameLength || !namePattern.MatchString(name) {
+		return fmt.Errorf("invalid key %q: name must be 1-63 alphanumerics, '-', '_' or '.', starting and ending with an alphanumeric", key)
+	}
+	return nil
+}
+
+// ValidateValue checks a label value: empty, or at most 63 alphanumerics, '-', '_' or '.'
+func ValidateValue(value string) error {
+	if value == "" {
+		return nil
+	}
+	if len(value) > maxNameLength || !namePattern.MatchString(value) {
+		return fmt.Errorf("invalid value %q: must be at most 63 alphanumerics, '-', '_' or '.', starting and ending with an alphanumeric", value)
+	}
+	return nil
+}
+
+// Changes are the updates requested by "key=value" and "key-" arguments
+type Changes struct {
+	Set    map[string]string
+	Remove []string
+}
+
+// ParseChanges parses "key=value" arguments to set and "key-" arguments to remove.
+// Label values are validated; annotation values may be any string.
+func ParseChanges(args []string, validateValues bool) (*Changes, error) {
+	changes := &Changes{Set: map[string]string{}}
+	for _, arg := range args {
+		if key, ok := strings.CutSuffix(arg, "-"); ok && !strings.Contains(arg, "=") {
+			if err := ValidateKey(key); err != nil {
+				return nil, err
+			}
+			changes.Remove = append(changes.Remove, key)
+			continue
+		}
+
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid argument %q: expected key=value or key-", arg)
+		}
+		if err := ValidateKey(key); err != nil {
+			return nil, err
+		}
+		if validateValues {
+			if err := ValidateValue(value); err != nil {
+				return nil, err
+			}
+		}
+		changes.Set[key] = value
+	}
+	return changes, nil
+}
+
+// Apply returns a copy of current with the changes applied. Changing the value of an existing
+// key is refused unless overwrite is set.
+func (c *Changes) Apply(current map[string]string, overwrite bool) (map[string]string, error) {
+	result := make(map[string]string, len(current)+len(c.Set))
+	for k, v := range current {
+		result[k] = v
+	}
+
+	for k, v := range c.Set {
+		if old, ok := result[k]; ok && old != v && !overwrite {
+			return nil, fmt.Errorf("'%s' already has a value (%s), and --overwrite is false", k, old)
+		}
+		result[k] = v
+	}
+	for _, k := range c.Remove {
+		delete(result, k)
+	}
+	return result, nil
+}
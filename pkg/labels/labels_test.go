@@ -0,0 +1,214 @@
+package labels
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		wantErr string
+	}{
+		{key: "env"},
+		{key: "app.kubernetes.io/name"},
+		{key: "nstream.ai/team_1"},
+		{key: "A-b.c_D"},
+		{key: strings.Repeat("a", 63)},
+		{key: "", wantErr: "name must be"},
+		{key: strings.Repeat("a", 64), wantErr: "name must be"},
+		{key: "-env", wantErr: "name must be"},
+		{key: "env.", wantErr: "name must be"},
+		{key: "env name", wantErr: "name must be"},
+		{key: "example.com/", wantErr: "name must be"},
+		{key: "Example.com/env", wantErr: "prefix must be"},
+		{key: "/env", wantErr: "prefix must be"},
+		{key: "a_b.com/env", wantErr: "prefix must be"},
+		{key: strings.Repeat("a", 254) + "/env", wantErr: "prefix must be"},
+		{key: "a/b/c", wantErr: "name must be"},
+	}
+
+	for _, tt := range tests {
+		err := ValidateKey(tt.key)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("ValidateKey(%q) error = %v", tt.key, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("ValidateKey(%q) error = %v, want %q", tt.key, err, tt.wantErr)
+		}
+	}
+}
+
+func TestValidateValue(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{value: ""},
+		{value: "prod"},
+		{value: "v1.2_3-rc"},
+		{value: strings.Repeat("a", 63)},
+		{value: strings.Repeat("a", 64), wantErr: true},
+		{value: "_prod", wantErr: true},
+		{value: "prod-", wantErr: true},
+		{value: "us east", wantErr: true},
+		{value: "a/b", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		if err := ValidateValue(tt.value); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateValue(%q) error = %v, want error %v", tt.value, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParseChanges(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           []string
+		validateValues bool
+		want           *Changes
+		wantErr        string
+	}{
+		{
+			name:           "set and remove",
+			args:           []string{"env=prod", "team-", "nstream.ai/tier=gold"},
+			validateValues: true,
+			want: &Changes{
+				Set:    map[string]string{"env": "prod", "nstream.ai/tier": "gold"},
+				Remove: []string{"team"},
+			},
+		},
+		{
+			name:           "empty value",
+			args:           []string{"env="},
+			validateValues: true,
+			want:           &Changes{Set: map[string]string{"env": ""}},
+		},
+		{
+			// A value that ends in '-' sets the key rather than removing it
+			name: "value ending in a dash",
+			args: []string{"note=in progress -"},
+			want: &Changes{Set: map[string]string{"note": "in progress -"}},
+		},
+		{
+			name: "annotation values are not validated",
+			args: []string{"description=Owned by the ML team, see the wiki"},
+			want: &Changes{Set: map[string]string{"description": "Owned by the ML team, see the wiki"}},
+		},
+		{
+			name:           "invalid label value",
+			args:           []string{"owner=ML team"},
+			validateValues: true,
+			wantErr:        `invalid value "ML team"`,
+		},
+		{
+			name:    "missing value",
+			args:    []string{"env"},
+			wantErr: `invalid argument "env": expected key=value or key-`,
+		},
+		{
+			name:    "invalid key to remove",
+			args:    []string{"-env-"},
+			wantErr: `invalid key "-env"`,
+		},
+		{
+			name:    "invalid key to set",
+			args:    []string{"Example.com/env=prod"},
+			wantErr: `invalid key "Example.com/env"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseChanges(tt.args, tt.validateValues)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("ParseChanges() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseChanges() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseChanges() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChangesApply(t *testing.T) {
+	current := map[string]string{"env": "dev", "team": "ml"}
+
+	tests := []struct {
+		name      string
+		args      []string
+		overwrite bool
+		want      map[string]string
+		wantErr   string
+	}{
+		{
+			name: "add a new key",
+			args: []string{"tier=gold"},
+			want: map[string]string{"env": "dev", "team": "ml", "tier": "gold"},
+		},
+		{
+			name: "remove a key",
+			args: []string{"team-"},
+			want: map[string]string{"env": "dev"},
+		},
+		{
+			name: "remove a missing key",
+			args: []string{"owner-"},
+			want: map[string]string{"env": "dev", "team": "ml"},
+		},
+		{
+			name: "set a key to its current value",
+			args: []string{"env=dev"},
+			want: map[string]string{"env": "dev", "team": "ml"},
+		},
+		{
+			name:    "change a value without overwrite",
+			args:    []string{"env=prod"},
+			wantErr: "'env' already has a value (dev), and --overwrite is false",
+		},
+		{
+			name:      "change a value with overwrite",
+			args:      []string{"env=prod", "team-"},
+			overwrite: true,
+			want:      map[string]string{"env": "prod"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := ParseChanges(tt.args, true)
+			if err != nil {
+				t.Fatalf("ParseChanges() error = %v", err)
+			}
+
+			got, err := changes.Apply(current, tt.overwrite)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Apply() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Apply() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if current["env"] != "dev" || current["team"] != "ml" || len(current) != 2 {
+		t.Errorf("Apply() modified the current labels: %v", current)
+	}
+}
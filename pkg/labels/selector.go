@@ -0,0 +1,175 @@
+package labels
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+// Selector operators
+const (
+	OpEquals       = "="
+	OpNotEquals    = "!="
+	OpIn           = "in"
+	OpNotIn        = "notin"
+	OpExists       = "exists"
+	OpDoesNotExist = "!exists"
+)
+
+// ParseSelector parses a comma-separated label selector such as
+// "env=prod,team!=ml,tier in (gold,silver),!deprecated". An empty string selects everything.
+func ParseSelector(selector string) (*clusterproto.LabelSelector, error) {
+	sel := &clusterproto.LabelSelector{}
+	for _, term := range splitTerms(selector) {
+		req, err := parseRequirement(term)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector %q: %v", selector, err)
+		}
+		sel.Requirements = append(sel.Requirements, req)
+	}
+
+	if len(sel.Requirements) == 0 {
+		return nil, nil
+	}
+	return sel, nil
+}
+
+// splitTerms splits a selector on commas that are not inside parentheses
+func splitTerms(selector string) []string {
+	var terms []string
+	depth, start := 0, 0
+	for i, r := range selector {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	terms = append(terms, selector[start:])
+
+	var nonEmpty []string
+	for _, t := range terms {
+		if t = strings.TrimSpace(t); t != "" {
+			nonEmpty = append(nonEmpty, t)
+		}
+	}
+	return nonEmpty
+}
+
+func parseRequirement(term string) (*clusterproto.LabelRequirement, error) {
+	if strings.HasPrefix(term, "!") {
+		key := strings.TrimSpace(term[1:])
+		if err := ValidateKey(key); err != nil {
+			return nil, err
+		}
+		return &clusterproto.LabelRequirement{Key: key, Operator: OpDoesNotExist}, nil
+	}
+
+	for _, op := range []string{"!=", "==", "="} {
+		if key, value, ok := strings.Cut(term, op); ok {
+			key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+			if err := ValidateKey(key); err != nil {
+				return nil, err
+			}
+			if err := ValidateValue(value); err != nil {
+				return nil, err
+			}
+			operator := OpEquals
+			if op == "!=" {
+				operator = OpNotEquals
+			}
+			return &clusterproto.LabelRequirement{Key: key, Operator: operator, Values: []string{value}}, nil
+		}
+	}
+
+	fields := strings.Fields(term)
+	if len(fields) >= 2 && (fields[1] == OpIn || fields[1] == OpNotIn) {
+		key := fields[0]
+		if err := ValidateKey(key); err != nil {
+			return nil, err
+		}
+
+		list := strings.TrimSpace(strings.Join(fields[2:], " "))
+		if !strings.HasPrefix(list, "(") || !strings.HasSuffix(list, ")") {
+			return nil, fmt.Errorf("%q: values must be given as (a,b,...)", term)
+		}
+
+		var values []string
+		for _, v := range strings.Split(list[1:len(list)-1], ",") {
+			v = strings.TrimSpace(v)
+			if err := ValidateValue(v); err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+		return &clusterproto.LabelRequirement{Key: key, Operator: fields[1], Values: values}, nil
+	}
+
+	if len(fields) == 1 {
+		if err := ValidateKey(term); err != nil {
+			return nil, err
+		}
+		return &clusterproto.LabelRequirement{Key: term, Operator: OpExists}, nil
+	}
+
+	return nil, fmt.Errorf("cannot parse %q", term)
+}
+
+// Matches reports whether labels satisfy every requirement of sel. A nil selector matches everything.
+func Matches(sel *clusterproto.LabelSelector, labels map[string]string) bool {
+	if sel == nil {
+		return true
+	}
+
+	for _, req := range sel.Requirements {
+		value, ok := labels[req.Key]
+		switch req.Operator {
+		case OpExists:
+			if !ok {
+				return false
+			}
+		case OpDoesNotExist:
+			if ok {
+				return false
+			}
+		case OpEquals, OpIn:
+			if !ok || !contains(req.Values, value) {
+				return false
+			}
+		case OpNotEquals, OpNotIn:
+			if ok && contains(req.Values, value) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Format renders labels as sorted "key=value" pairs separated by commas
+func Format(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
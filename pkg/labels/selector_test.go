@@ -0,0 +1,149 @@
+package labels
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+// requirements renders the requirements of sel as "key op value,value" strings
+func requirements(sel *clusterproto.LabelSelector) []string {
+	if sel == nil {
+		return nil
+	}
+
+	var reqs []string
+	for _, req := range sel.Requirements {
+		reqs = append(reqs, strings.TrimSpace(req.Key+" "+req.Operator+" "+strings.Join(req.Values, ",")))
+	}
+	return reqs
+}
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		selector string
+		want     []string
+	}{
+		{selector: "", want: nil},
+		{selector: " , ", want: nil},
+		{selector: "env=prod", want: []string{"env = prod"}},
+		{selector: "env==prod", want: []string{"env = prod"}},
+		{selector: "env = prod , team != ml", want: []string{"env = prod", "team != ml"}},
+		{selector: "env=", want: []string{"env ="}},
+		{selector: "nstream.ai/tier=gold", want: []string{"nstream.ai/tier = gold"}},
+		{selector: "gpu", want: []string{"gpu exists"}},
+		{selector: "!deprecated", want: []string{"deprecated !exists"}},
+		{selector: "! deprecated", want: []string{"deprecated !exists"}},
+		{
+			// Commas inside parentheses separate values, not requirements
+			selector: "env=prod,tier in (gold,silver),region notin (us-east-1, eu-west-1),!deprecated",
+			want: []string{
+				"env = prod",
+				"tier in gold,silver",
+				"region notin us-east-1,eu-west-1",
+				"deprecated !exists",
+			},
+		},
+		{selector: "tier in (gold)", want: []string{"tier in gold"}},
+		{selector: "tier in ( gold , silver )", want: []string{"tier in gold,silver"}},
+	}
+
+	for _, tt := range tests {
+		sel, err := ParseSelector(tt.selector)
+		if err != nil {
+			t.Errorf("ParseSelector(%q) error = %v", tt.selector, err)
+			continue
+		}
+		if got := requirements(sel); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseSelector(%q) = %q, want %q", tt.selector, got, tt.want)
+		}
+	}
+}
+
+func TestParseSelectorErrors(t *testing.T) {
+	tests := []struct {
+		selector string
+		wantErr  string
+	}{
+		{selector: "Env.com/x=prod", wantErr: "prefix must be"},
+		{selector: "env=prod env", wantErr: `invalid value "prod env"`},
+		{selector: "!-env", wantErr: `invalid key "-env"`},
+		{selector: "tier in gold,silver", wantErr: "values must be given as (a,b,...)"},
+		{selector: "tier in (gold", wantErr: "values must be given as (a,b,...)"},
+		{selector: "tier in (gold,-x)", wantErr: `invalid value "-x"`},
+		{selector: "tier notin", wantErr: "values must be given as (a,b,...)"},
+		{selector: "tier gold", wantErr: `cannot parse "tier gold"`},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseSelector(tt.selector)
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("ParseSelector(%q) error = %v, want %q", tt.selector, err, tt.wantErr)
+		}
+	}
+}
+
+func TestSplitTerms(t *testing.T) {
+	tests := []struct {
+		selector string
+		want     []string
+	}{
+		{selector: "", want: nil},
+		{selector: "a=b", want: []string{"a=b"}},
+		{selector: "a=b,,c", want: []string{"a=b", "c"}},
+		{selector: "a in (x,y), b notin (z)", want: []string{"a in (x,y)", "b notin (z)"}},
+	}
+
+	for _, tt := range tests {
+		if got := splitTerms(tt.selector); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitTerms(%q) = %q, want %q", tt.selector, got, tt.want)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	labels := map[string]string{"env": "prod", "tier": "gold", "team": "ml"}
+
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{selector: "", want: true},
+		{selector: "env=prod", want: true},
+		{selector: "env=dev", want: false},
+		{selector: "env!=dev", want: true},
+		{selector: "owner!=alice", want: true},
+		{selector: "tier in (gold,silver)", want: true},
+		{selector: "tier in (silver)", want: false},
+		{selector: "owner in (alice)", want: false},
+		{selector: "tier notin (gold)", want: false},
+		{selector: "owner notin (alice)", want: true},
+		{selector: "team", want: true},
+		{selector: "owner", want: false},
+		{selector: "!owner", want: true},
+		{selector: "!team", want: false},
+		{selector: "env=prod,tier in (gold),!owner", want: true},
+		{selector: "env=prod,owner", want: false},
+	}
+
+	for _, tt := range tests {
+		sel, err := ParseSelector(tt.selector)
+		if err != nil {
+			t.Fatalf("ParseSelector(%q) error = %v", tt.selector, err)
+		}
+		if got := Matches(sel, labels); got != tt.want {
+			t.Errorf("Matches(%q) = %v, want %v", tt.selector, got, tt.want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	if got := Format(map[string]string{"team": "ml", "env": "prod", "gpu": ""}); got != "env=prod,gpu=,team=ml" {
+		t.Errorf("Format() = %q", got)
+	}
+	if got := Format(nil); got != "" {
+		t.Errorf("Format(nil) = %q, want an empty string", got)
+	}
+}
@@ -0,0 +1,187 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	logsproto "github.com/nstreama-ai/nstream-ai-cli/proto/logs"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Resource kinds that produce logs
+const (
+	KindGraph     = "graph"
+	KindConnector = "connector"
+	KindFinetuner = "finetuner"
+)
+
+// Kinds lists the resource kinds accepted by StreamLogs
+var Kinds = []string{KindGraph, KindConnector, KindFinetuner}
+
+// Operations handles log-related operations
+type Operations struct {
+	client *client.Client
+	config *config.Config
+}
+
+// NewOperationsWithClient creates an Operations instance that reuses an existing client and config
+func NewOperationsWithClient(c *client.Client, cfg *config.Config) *Operations {
+	return &Operations{
+		client: c,
+		config: cfg,
+	}
+}
+
+// StreamOptions selects the log lines to stream
+type StreamOptions struct {
+	ClusterName string
+	Kind        string
+	Name        string
+	// Replica is optional; all replicas are streamed when empty
+	Replica string
+	// Since is optional; only lines logged after it are streamed
+	Since time.Time
+	// Tail limits the initial output to the last lines per replica; 0 means no limit
+	Tail   int32
+	Follow bool
+}
+
+// StreamLogs streams log lines, calling onEntry for each one, until the server ends the
+// stream or ctx is cancelled. A dropped stream is reopened from the oldest of the last
+// timestamps seen per replica, and the lines already streamed are skipped.
+func (o *Operations) StreamLogs(ctx context.Context, opts StreamOptions, onEntry func(*logsproto.LogEntry)) error {
+	if o.config.User.AuthToken == "" {
+		return fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	req := &logsproto.StreamLogsRequest{
+		ClusterName: opts.ClusterName,
+		Kind:        opts.Kind,
+		Name:        opts.Name,
+		Replica:     opts.Replica,
+		Tail:        opts.Tail,
+		Follow:      opts.Follow,
+		AuthToken:   o.config.User.AuthToken,
+	}
+	if !opts.Since.IsZero() {
+		req.Since = timestamppb.New(opts.Since)
+	}
+
+	attempt := 0
+	seen := positions{}
+
+	for {
+		stream, err := o.client.LogsClient.StreamLogs(ctx, req)
+
+		for err == nil {
+			var entry *logsproto.LogEntry
+			entry, err = stream.Recv()
+			if err != nil {
+				break
+			}
+
+			attempt = 0
+			if !seen.advance(entry) {
+				continue
+			}
+			if onEntry != nil {
+				onEntry(entry)
+			}
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+		// Without --follow the server ends the stream once the requested lines are sent
+		if !opts.Follow && err == io.EOF {
+			return nil
+		}
+		if !client.IsRetryable(err) || attempt >= client.MaxReconnectAttempts {
+			return fmt.Errorf("failed to stream logs: %v", err)
+		}
+
+		if err := client.Sleep(ctx, client.Backoff(attempt)); err != nil {
+			return nil
+		}
+		attempt++
+
+		// Do not replay the tail, and resume where the furthest behind replica stopped
+		if since, ok := seen.resume(); ok {
+			req.Since = timestamppb.New(since)
+			req.Tail = 0
+		}
+	}
+}
+
+// position is the last timestamp streamed for a replica and how many times each line was
+// streamed at it
+type position struct {
+	at    time.Time
+	lines map[string]int
+	// replay counts the lines at the timestamp that a reopened stream sends again
+	replay map[string]int
+}
+
+// positions tracks the lines streamed per replica, so that a reopened stream can start
+// early enough for every replica without repeating lines
+type positions map[string]*position
+
+// advance records entry and reports whether it is new. Entries of a reopened stream up to
+// the position of their replica are repeats.
+func (p positions) advance(entry *logsproto.LogEntry) bool {
+	if entry.Timestamp == nil {
+		return true
+	}
+	at := entry.Timestamp.AsTime()
+	line := entry.Stream + "\x00" + entry.Line
+
+	pos, ok := p[entry.Replica]
+	if !ok {
+		pos = &position{at: at, lines: map[string]int{}}
+		p[entry.Replica] = pos
+	}
+
+	if pos.replay != nil {
+		if at.Before(pos.at) {
+			return false
+		}
+		if at.Equal(pos.at) && pos.replay[line] > 0 {
+			pos.replay[line]--
+			return false
+		}
+	}
+
+	switch {
+	case at.After(pos.at):
+		pos.at = at
+		pos.lines = map[string]int{}
+		pos.replay = nil
+		pos.lines[line]++
+	case at.Equal(pos.at):
+		pos.lines[line]++
+	}
+	return true
+}
+
+// resume prepares for a reopened stream and returns the time to resume after: just before
+// the oldest of the replica positions, since the server only sends lines strictly after it
+func (p positions) resume() (time.Time, bool) {
+	var since time.Time
+	for _, pos := range p {
+		pos.replay = make(map[string]int, len(pos.lines))
+		for line, n := range pos.lines {
+			pos.replay[line] = n
+		}
+		if since.IsZero() || pos.at.Before(since) {
+			since = pos.at
+		}
+	}
+	if since.IsZero() {
+		return since, false
+	}
+	return since.Add(-time.Nanosecond), true
+}
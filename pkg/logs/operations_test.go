@@ -0,0 +1,79 @@
+package logs
+
+import (
+	"testing"
+	"time"
+
+	logsproto "github.com/nstreama-ai/nstream-ai-cli/proto/logs"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var start = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func entry(replica string, second int, line string) *logsproto.LogEntry {
+	return &logsproto.LogEntry{
+		Timestamp: timestamppb.New(start.Add(time.Duration(second) * time.Second)),
+		Replica:   replica,
+		Stream:    "stdout",
+		Line:      line,
+	}
+}
+
+func TestPositionsResume(t *testing.T) {
+	seen := positions{}
+	if _, ok := seen.resume(); ok {
+		t.Error("resume() before any line returned a time")
+	}
+
+	// Replica b is behind a when the stream drops, and two lines of a share a timestamp
+	for _, e := range []*logsproto.LogEntry{
+		entry("a", 1, "a1"),
+		entry("b", 2, "b2"),
+		entry("a", 5, "a5"),
+		entry("a", 5, "a5"),
+	} {
+		if !seen.advance(e) {
+			t.Fatalf("advance(%s) of a new line = false", e.Line)
+		}
+	}
+
+	since, ok := seen.resume()
+	if !ok || !since.Equal(start.Add(2*time.Second-time.Nanosecond)) {
+		t.Fatalf("resume() = %v, %v, want just before the last line of b", since, ok)
+	}
+
+	// The reopened stream sends everything after since
+	tests := []struct {
+		entry *logsproto.LogEntry
+		want  bool
+	}{
+		{entry("b", 2, "b2"), false},
+		{entry("b", 3, "b3"), true},
+		{entry("a", 4, "a4"), false},
+		{entry("a", 5, "a5"), false},
+		{entry("a", 5, "a5"), false},
+		{entry("a", 5, "a5"), true},
+		{entry("a", 5, "another line at 5"), true},
+		{entry("a", 6, "a6"), true},
+		{entry("c", 3, "c3"), true},
+	}
+	for _, tt := range tests {
+		if got := seen.advance(tt.entry); got != tt.want {
+			t.Errorf("advance(%s %s) = %v, want %v", tt.entry.Replica, tt.entry.Line, got, tt.want)
+		}
+	}
+}
+
+func TestPositionsWithoutReconnect(t *testing.T) {
+	seen := positions{}
+
+	// Identical lines are all streamed until a stream is reopened
+	for i := 0; i < 3; i++ {
+		if !seen.advance(entry("a", 1, "")) {
+			t.Errorf("advance() of blank line %d = false", i)
+		}
+	}
+	if !seen.advance(&logsproto.LogEntry{Line: "no timestamp"}) {
+		t.Error("advance() of a line without a timestamp = false")
+	}
+}
@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/client"
+	"github.com/nstreama-ai/nstream-ai-cli/pkg/config"
+	metricsproto "github.com/nstreama-ai/nstream-ai-cli/proto/metrics"
+)
+
+// Resource kinds that report metrics
+const (
+	KindCluster   = "cluster"
+	KindGraph     = "graph"
+	KindConnector = "connector"
+	KindModel     = "model"
+)
+
+// Operations handles metrics-related operations
+type Operations struct {
+	client *client.Client
+	config *config.Config
+}
+
+// NewOperationsWithClient creates an Operations instance that reuses an existing client and config
+func NewOperationsWithClient(c *client.Client, cfg *config.Config) *Operations {
+	return &Operations{
+		client: c,
+		config: cfg,
+	}
+}
+
+// WatchMetrics streams metrics snapshots of resources of the given kind, calling onSnapshot
+// for each one, until ctx is cancelled. With once set it returns after the first snapshot.
+// A dropped stream is reopened.
+func (o *Operations) WatchMetrics(ctx context.Context, clusterName, kind string, interval time.Duration, once bool, onSnapshot func(*metricsproto.MetricsSnapshot)) error {
+	if o.config.User.AuthToken == "" {
+		return fmt.Errorf("authentication token is missing. Please sign in first")
+	}
+
+	req := &metricsproto.WatchMetricsRequest{
+		ClusterName:     clusterName,
+		Kind:            kind,
+		IntervalSeconds: int32(interval / time.Second),
+		Once:            once,
+		AuthToken:       o.config.User.AuthToken,
+	}
+
+	attempt := 0
+
+	for {
+		stream, err := o.client.MetricsClient.WatchMetrics(ctx, req)
+
+		for err == nil {
+			var snapshot *metricsproto.MetricsSnapshot
+			snapshot, err = stream.Recv()
+			if err != nil {
+				break
+			}
+
+			attempt = 0
+			if onSnapshot != nil {
+				onSnapshot(snapshot)
+			}
+			if once {
+				return nil
+			}
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+		if once && err == io.EOF {
+			return fmt.Errorf("no metrics received")
+		}
+		if !client.IsRetryable(err) || attempt >= client.MaxReconnectAttempts {
+			return fmt.Errorf("failed to watch metrics: %v", err)
+		}
+
+		if err := client.Sleep(ctx, client.Backoff(attempt)); err != nil {
+			return nil
+		}
+		attempt++
+	}
+}
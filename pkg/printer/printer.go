@@ -0,0 +1,273 @@
+package printer
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// Supported output formats
+const (
+	FormatTable = "table"
+	FormatWide  = "wide"
+	FormatJSON  = "json"
+	FormatYAML  = "yaml"
+	FormatName  = "name"
+	FormatCSV   = "csv"
+)
+
+// Formats lists the output formats supported by get commands in the order they are documented
+var Formats = []string{FormatTable, FormatWide, FormatJSON, FormatYAML, FormatName}
+
+// Column describes one column of table output
+type Column[T proto.Message] struct {
+	Header string
+	// Wide columns are only shown with -o wide
+	Wide  bool
+	Value func(T) string
+}
+
+// Options describes how a resource kind is printed
+type Options[T proto.Message] struct {
+	// Kind is the resource kind used by -o name, e.g. "cluster"
+	Kind    string
+	Name    func(T) string
+	Columns []Column[T]
+}
+
+// ValidateFormat returns an error if format is not one of allowed, which defaults to Formats
+func ValidateFormat(format string, allowed ...string) error {
+	if len(allowed) == 0 {
+		allowed = Formats
+	}
+	for _, f := range allowed {
+		if format == f {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported output format %q (must be one of %s)", format, strings.Join(allowed, ", "))
+}
+
+// FormatHelp returns the help text for an --output flag accepting allowed, which defaults to Formats
+func FormatHelp(allowed ...string) string {
+	if len(allowed) == 0 {
+		allowed = Formats
+	}
+	return fmt.Sprintf("Output format (%s)", strings.Join(allowed, ", "))
+}
+
+// PrintList writes a list of resources in the given format
+func PrintList[T proto.Message](w io.Writer, format string, items []T, opts Options[T]) error {
+	lw := NewListWriter(w, format, opts)
+	if err := lw.Write(items); err != nil {
+		return err
+	}
+	return lw.Close()
+}
+
+// ListWriter prints a list that arrives in pages. Table, wide, name and CSV rows are written
+// as each page arrives; JSON and YAML are written by Close since they form a single document.
+type ListWriter[T proto.Message] struct {
+	w       io.Writer
+	format  string
+	opts    Options[T]
+	started bool
+	values  []interface{}
+}
+
+// NewListWriter creates a ListWriter for the given format
+func NewListWriter[T proto.Message](w io.Writer, format string, opts Options[T]) *ListWriter[T] {
+	return &ListWriter[T]{
+		w:      w,
+		format: format,
+		opts:   opts,
+		values: []interface{}{},
+	}
+}
+
+// Write prints one page of items. The table header is printed with the first page.
+func (lw *ListWriter[T]) Write(items []T) error {
+	switch lw.format {
+	case FormatJSON, FormatYAML:
+		for _, item := range items {
+			v, err := toGeneric(item)
+			if err != nil {
+				return err
+			}
+			lw.values = append(lw.values, v)
+		}
+		return nil
+	default:
+		header := !lw.started
+		lw.started = true
+		return printRows(lw.w, lw.format, items, lw.opts, header)
+	}
+}
+
+// Close finishes the list, writing JSON and YAML output
+func (lw *ListWriter[T]) Close() error {
+	switch lw.format {
+	case FormatJSON, FormatYAML:
+		return encode(lw.w, lw.format, lw.values)
+	default:
+		return nil
+	}
+}
+
+// Pager yields a list one page at a time, returning io.EOF after the last page
+type Pager[T any] interface {
+	NextPage(ctx context.Context) ([]T, error)
+}
+
+// PrintPages prints every page from p as it arrives and returns the number of items printed.
+// An empty table or wide list prints nothing so callers can show their own message.
+func PrintPages[T proto.Message](ctx context.Context, w io.Writer, format string, p Pager[T], opts Options[T]) (int, error) {
+	lw := NewListWriter(w, format, opts)
+	total := 0
+	for {
+		items, err := p.NextPage(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return total, err
+		}
+		if len(items) == 0 {
+			continue
+		}
+
+		if err := lw.Write(items); err != nil {
+			return total, err
+		}
+		total += len(items)
+	}
+
+	if total == 0 {
+		if format == FormatTable || format == FormatWide {
+			return 0, nil
+		}
+		if err := lw.Write(nil); err != nil {
+			return 0, err
+		}
+	}
+	return total, lw.Close()
+}
+
+// PrintItem writes a single resource in the given format
+func PrintItem[T proto.Message](w io.Writer, format string, item T, opts Options[T]) error {
+	switch format {
+	case FormatJSON, FormatYAML:
+		v, err := toGeneric(item)
+		if err != nil {
+			return err
+		}
+		return encode(w, format, v)
+	default:
+		return printRows(w, format, []T{item}, opts, true)
+	}
+}
+
+// printRows writes items as rows, preceded by the column headers when header is set. Each call
+// aligns its own rows, so pages streamed by a ListWriter are aligned page by page.
+func printRows[T proto.Message](w io.Writer, format string, items []T, opts Options[T], header bool) error {
+	switch format {
+	case FormatName:
+		for _, item := range items {
+			fmt.Fprintf(w, "%s/%s\n", opts.Kind, opts.Name(item))
+		}
+		return nil
+	case FormatCSV:
+		// CSV is meant for spreadsheets, so it always carries every column
+		cw := csv.NewWriter(w)
+		headers := make([]string, len(opts.Columns))
+		for i, c := range opts.Columns {
+			headers[i] = c.Header
+		}
+		if header {
+			if err := cw.Write(headers); err != nil {
+				return err
+			}
+		}
+		for _, item := range items {
+			row := make([]string, len(opts.Columns))
+			for i, c := range opts.Columns {
+				row[i] = c.Value(item)
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	case FormatTable, FormatWide:
+		var columns []Column[T]
+		for _, c := range opts.Columns {
+			if !c.Wide || format == FormatWide {
+				columns = append(columns, c)
+			}
+		}
+
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		headers := make([]string, len(columns))
+		for i, c := range columns {
+			headers[i] = c.Header
+		}
+		if header {
+			fmt.Fprintln(tw, strings.Join(headers, "\t"))
+		}
+
+		for _, item := range items {
+			row := make([]string, len(columns))
+			for i, c := range columns {
+				row[i] = c.Value(item)
+				if row[i] == "" {
+					row[i] = "<none>"
+				}
+			}
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	default:
+		return ValidateFormat(format)
+	}
+}
+
+// toGeneric converts a message to plain maps using its proto JSON field names
+func toGeneric(m proto.Message) (interface{}, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode output: %v", err)
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("failed to encode output: %v", err)
+	}
+	return v, nil
+}
+
+func encode(w io.Writer, format string, v interface{}) error {
+	if format == FormatYAML {
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(v); err != nil {
+			return fmt.Errorf("failed to encode output: %v", err)
+		}
+		_, err := w.Write(buf.Bytes())
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// AccessParams describes the bucket access to set up. Project, Subscription, ResourceGroup
+// and StorageAccount are optional: templates leave them as inputs to fill in when the
+// template is applied, and json-policy requires the ones it needs.
+type AccessParams struct {
+	Cloud  string
+	Bucket string
+	// Role is the IAM role on aws, the service account ID on gcp and the managed identity
+	// name on azure that NStream uses to access the bucket. On s3compatible it is the ARN of
+	// the role assumed with sts credentials, or empty with static credentials.
+	Role string
+
+	// ServiceRole, ExternalID and Issuer come from GetServiceRole
+	ServiceRole string
+	ExternalID  string
+	Issuer      string
+
+	// KMSKey is the customer-managed key of the bucket, if any. On aws the role is granted
+	// the key; on gcp and azure only the storage service uses it.
+	KMSKey string
+
+	// Project is the gcp project of the service account
+	Project string
+	// Subscription, ResourceGroup and StorageAccount locate the container on azure
+	Subscription   string
+	ResourceGroup  string
+	StorageAccount string
+}
+
+// Validate checks that the parameters can be rendered safely into every template
+func (p AccessParams) Validate() error {
+	prov, err := Get(p.Cloud)
+	if err != nil {
+		return err
+	}
+
+	if err := prov.ValidateBucketName(p.Bucket); err != nil {
+		return err
+	}
+	if err := prov.ValidateRole(p.Role); err != nil {
+		return err
+	}
+
+	if p.KMSKey != "" {
+		if err := prov.ValidateKMSKey(p.KMSKey); err != nil {
+			return err
+		}
+	}
+
+	if p.ServiceRole == "" && prov.UsesServiceRole() {
+		return fmt.Errorf("no NStream service role for %s", p.Cloud)
+	}
+	if strings.ContainsAny(p.ServiceRole+p.ExternalID+p.Issuer, "\"'`$\\\n") {
+		return fmt.Errorf("NStream service role for %s contains unexpected characters", p.Cloud)
+	}
+
+	switch {
+	case p.Project != "" && !gcpProjectPattern.MatchString(p.Project):
+		return fmt.Errorf("invalid project ID '%s'", p.Project)
+	case p.StorageAccount != "" && !azureAccountPattern.MatchString(p.StorageAccount):
+		return fmt.Errorf("invalid storage account name '%s': must be 3-24 lowercase letters and numbers", p.StorageAccount)
+	case p.Subscription != "" && !azureUUIDPattern.MatchString(p.Subscription):
+		return fmt.Errorf("invalid subscription ID '%s': must be a UUID", p.Subscription)
+	case p.ResourceGroup != "" && !azureGroupPattern.MatchString(p.ResourceGroup):
+		return fmt.Errorf("invalid resource group name '%s'", p.ResourceGroup)
+	}
+	return nil
+}
+
+// RenderAccessTemplate renders the infrastructure that grants the NStream service role
+// access to the bucket in the given format
+func RenderAccessTemplate(format string, p AccessParams) (string, error) {
+	prov, err := Get(p.Cloud)
+	if err != nil {
+		return "", err
+	}
+
+	formats := prov.AccessFormats()
+	supported := false
+	for _, f := range formats {
+		if f == format {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return "", fmt.Errorf("invalid format '%s' for %s (must be one of %s)", format, p.Cloud, strings.Join(formats, ", "))
+	}
+
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+
+	return prov.RenderAccess(format, p)
+}
+
+// Actions granted on the bucket by aws and s3compatible, which both implement the S3 API
+var (
+	s3BucketActions = []string{"s3:ListBucket", "s3:GetBucketLocation", "s3:ListBucketMultipartUploads"}
+	s3ObjectActions = []string{"s3:GetObject", "s3:PutObject", "s3:DeleteObject", "s3:AbortMultipartUpload", "s3:ListMultipartUploadParts"}
+)
+
+// roleARNPattern matches the ARN of an IAM role on aws or an S3-compatible server
+var roleARNPattern = regexp.MustCompile(`^arn:[\w+=/,.@:-]+$`)
+
+// iamPolicy is an AWS IAM policy document
+type iamPolicy struct {
+	Version   string         `json:"Version"`
+	Statement []iamStatement `json:"Statement"`
+}
+
+type iamStatement struct {
+	Sid       string                       `json:"Sid,omitempty"`
+	Effect    string                       `json:"Effect"`
+	Principal map[string]string            `json:"Principal,omitempty"`
+	Action    interface{}                  `json:"Action"`
+	Resource  interface{}                  `json:"Resource,omitempty"`
+	Condition map[string]map[string]string `json:"Condition,omitempty"`
+}
+
+// s3PermissionPolicy grants read and write access to the bucket
+func s3PermissionPolicy(p AccessParams) iamPolicy {
+	bucketARN := "arn:aws:s3:::" + p.Bucket
+	return iamPolicy{
+		Version: "2012-10-17",
+		Statement: []iamStatement{
+			{Sid: "NStreamListBucket", Effect: "Allow", Action: s3BucketActions, Resource: bucketARN},
+			{Sid: "NStreamReadWriteObjects", Effect: "Allow", Action: s3ObjectActions, Resource: bucketARN + "/*"},
+		},
+	}
+}
+
+// renderJSON renders a policy document as indented JSON
+func renderJSON(doc interface{}) (string, error) {
+	out, err := indentJSON(doc, "")
+	if err != nil {
+		return "", err
+	}
+	return out + "\n", nil
+}
+
+// indentJSON renders v as indented JSON with every line prefixed by prefix
+func indentJSON(v interface{}, prefix string) (string, error) {
+	out, err := json.MarshalIndent(v, prefix, "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to render policy: %v", err)
+	}
+	return prefix + string(out), nil
+}
+
+// parseTemplates parses the text templates of a provider's access formats, each defined
+// under the name "<cloud>-<format>"
+func parseTemplates(text string) *template.Template {
+	return template.Must(template.New("access").Funcs(template.FuncMap{
+		"quoteList":  quoteList,
+		"tfVariable": tfVariable,
+	}).Parse(text))
+}
+
+// executeTemplate renders one of the access templates
+func executeTemplate(t *template.Template, name string, data interface{}) (string, error) {
+	var b strings.Builder
+	if err := t.ExecuteTemplate(&b, name, data); err != nil {
+		return "", fmt.Errorf("failed to render %s template: %v", name, err)
+	}
+	return b.String(), nil
+}
+
+// quoteList renders values as a comma-separated list of double-quoted strings
+func quoteList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = `"` + v + `"`
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// tfVariable renders a Terraform string variable, with a default when value is set
+func tfVariable(name, value string) string {
+	if value == "" {
+		return fmt.Sprintf("variable %q {\n  type = string\n}", name)
+	}
+	return fmt.Sprintf("variable %q {\n  type    = string\n  default = %q\n}", name, value)
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// testParams are complete access parameters for each cloud, with a customer-managed key so
+// that key-policy renders too
+var testParams = map[string]AccessParams{
+	"aws": {
+		Bucket:      "training-data",
+		Role:        "nstream-bucket-access",
+		ServiceRole: "arn:aws:iam::111122223333:role/nstream-service",
+		ExternalID:  "org-1234",
+		KMSKey:      "arn:aws:kms:us-east-1:444455556666:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+	},
+	"gcp": {
+		Bucket:      "training-data",
+		Role:        "nstream-bucket-access",
+		ServiceRole: "nstream-service@nstream-prod.iam.gserviceaccount.com",
+		KMSKey:      "projects/my-project/locations/us/keyRings/nstream/cryptoKeys/training-data",
+		Project:     "my-project",
+	},
+	"azure": {
+		Bucket:         "training-data",
+		Role:           "nstream-bucket-access",
+		ServiceRole:    "system:serviceaccount:org-1234:bucket-access",
+		Issuer:         "https://oidc.nstream.ai/org-1234",
+		KMSKey:         "https://my-vault.vault.azure.net/keys/training-data",
+		Subscription:   "00000000-1111-2222-3333-444444444444",
+		ResourceGroup:  "storage-rg",
+		StorageAccount: "nstreamdata",
+	},
+	S3Compatible: {
+		Bucket: "training-data",
+		Role:   "arn:minio:iam:::role/nstream",
+		KMSKey: "training-data-key",
+	},
+}
+
+// checkGolden compares got with testdata/<name>.golden, rewriting it with -update
+func checkGolden(t *testing.T, name, got string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read %s (run go test with -update to create it): %v", path, err)
+	}
+	if got != string(want) {
+		t.Errorf("%s does not match the rendered template (run go test with -update to accept):\n%s", path, got)
+	}
+}
+
+func TestRenderAccessTemplate(t *testing.T) {
+	for _, p := range All() {
+		params, ok := testParams[p.Name()]
+		if !ok {
+			t.Errorf("no test parameters for %s", p.Name())
+			continue
+		}
+		params.Cloud = p.Name()
+
+		for _, format := range p.AccessFormats() {
+			name := p.Name() + "-" + format
+			t.Run(name, func(t *testing.T) {
+				got, err := RenderAccessTemplate(format, params)
+				if err != nil {
+					t.Fatalf("RenderAccessTemplate() error = %v", err)
+				}
+				checkGolden(t, name, got)
+			})
+		}
+	}
+}
+
+func TestRenderAccessTemplateVariants(t *testing.T) {
+	tests := []struct {
+		name   string
+		cloud  string
+		format string
+		modify func(p *AccessParams)
+	}{
+		{
+			// Without a key the role is not granted any KMS permissions
+			name:   "aws-json-policy-no-kms-key",
+			cloud:  "aws",
+			format: "json-policy",
+			modify: func(p *AccessParams) { p.KMSKey = "" },
+		},
+		{
+			// IAM policies match alias ARNs on the alias instead of naming the key
+			name:   "aws-json-policy-alias-key",
+			cloud:  "aws",
+			format: "json-policy",
+			modify: func(p *AccessParams) { p.KMSKey = "arn:aws:kms:us-east-1:444455556666:alias/training-data" },
+		},
+		{
+			// Optional inputs are left to fill in when the template is applied
+			name:   "gcp-gcloud-script-no-project",
+			cloud:  "gcp",
+			format: "gcloud-script",
+			modify: func(p *AccessParams) { p.Project = "" },
+		},
+		{
+			name:   "azure-terraform-no-storage-account",
+			cloud:  "azure",
+			format: "terraform",
+			modify: func(p *AccessParams) { p.ResourceGroup = ""; p.StorageAccount = "" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := testParams[tt.cloud]
+			params.Cloud = tt.cloud
+			tt.modify(&params)
+
+			got, err := RenderAccessTemplate(tt.format, params)
+			if err != nil {
+				t.Fatalf("RenderAccessTemplate() error = %v", err)
+			}
+			checkGolden(t, tt.name, got)
+		})
+	}
+}
+
+func TestRenderAccessTemplateErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		cloud   string
+		format  string
+		modify  func(p *AccessParams)
+		wantErr string
+	}{
+		{
+			name:    "unsupported format",
+			cloud:   "gcp",
+			format:  "cloudformation",
+			modify:  func(p *AccessParams) {},
+			wantErr: "invalid format 'cloudformation' for gcp",
+		},
+		{
+			name:    "key-policy without a key",
+			cloud:   "aws",
+			format:  "key-policy",
+			modify:  func(p *AccessParams) { p.KMSKey = "" },
+			wantErr: "a KMS key is required",
+		},
+		{
+			name:    "gcp json-policy without a project",
+			cloud:   "gcp",
+			format:  "json-policy",
+			modify:  func(p *AccessParams) { p.Project = "" },
+			wantErr: "a project is required",
+		},
+		{
+			name:    "azure without an issuer",
+			cloud:   "azure",
+			format:  "bicep",
+			modify:  func(p *AccessParams) { p.Issuer = "" },
+			wantErr: "has no issuer",
+		},
+		{
+			name:    "service role with quotes",
+			cloud:   "aws",
+			format:  "terraform",
+			modify:  func(p *AccessParams) { p.ServiceRole = `arn"` },
+			wantErr: "unexpected characters",
+		},
+		{
+			name:    "key of another cloud",
+			cloud:   "gcp",
+			format:  "key-policy",
+			modify:  func(p *AccessParams) { p.KMSKey = testParams["aws"].KMSKey },
+			wantErr: "invalid KMS key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := testParams[tt.cloud]
+			params.Cloud = tt.cloud
+			tt.modify(&params)
+
+			_, err := RenderAccessTemplate(tt.format, params)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("RenderAccessTemplate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+)
+
+var (
+	awsNamePattern   = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]*[a-z0-9]$`)
+	awsRolePattern   = regexp.MustCompile(`^[\w+=,.@-]{1,64}$`)
+	awsKMSKeyPattern = regexp.MustCompile(`^arn:(aws[a-z-]*):kms:[a-z0-9-]+:(\d{12}):(key/[a-zA-Z0-9-]+|alias/[\w/-]+)$`)
+)
+
+// Permissions granted on customer-managed keys
+var awsKMSActions = []string{"kms:Decrypt", "kms:Encrypt", "kms:GenerateDataKey", "kms:DescribeKey"}
+
+var awsTemplates = parseTemplates(`
+{{- define "aws-terraform" -}}
+# Grants NStream access to the S3 bucket '{{.Bucket}}' through the IAM role '{{.Role}}'.
+# Apply with: terraform init && terraform apply
+
+resource "aws_iam_role" "nstream_bucket_access" {
+  name               = "{{.Role}}"
+  assume_role_policy = <<-EOT
+{{.TrustPolicy}}
+  EOT
+}
+
+resource "aws_iam_role_policy" "nstream_bucket_access" {
+  name   = "nstream-bucket-access"
+  role   = aws_iam_role.nstream_bucket_access.id
+  policy = <<-EOT
+{{.PermissionPolicy}}
+  EOT
+}
+
+output "role_arn" {
+  value = aws_iam_role.nstream_bucket_access.arn
+}
+{{end}}
+`)
+
+// awsTemplateData is what the aws access templates are rendered from
+type awsTemplateData struct {
+	AccessParams
+
+	TrustPolicy      string
+	PermissionPolicy string
+}
+
+// aws is Amazon Web Services: S3 buckets accessed through an IAM role that the NStream
+// service role assumes
+type aws struct{}
+
+func init() {
+	Register(aws{})
+}
+
+func (aws) Name() string          { return "aws" }
+func (aws) DisplayName() string   { return "AWS" }
+func (aws) RoleType() string      { return "IAM Role" }
+func (aws) DefaultRole() string   { return DefaultAccessRole }
+func (aws) DefaultRegion() string { return "" }
+func (aws) UsesServiceRole() bool { return true }
+
+func (aws) StorageClasses() []string {
+	return []string{"STANDARD", "INTELLIGENT_TIERING", "STANDARD_IA", "ONEZONE_IA", "GLACIER_IR"}
+}
+
+func (aws) AccessFormats() []string {
+	return []string{"cloudformation", "terraform", "json-policy", "key-policy"}
+}
+
+// ValidateBucketName follows the S3 general purpose bucket naming rules
+func (aws) ValidateBucketName(name string) error {
+	return validateS3Name(name)
+}
+
+func (aws) ValidateRole(role string) error {
+	if !awsRolePattern.MatchString(role) {
+		return fmt.Errorf("invalid role name '%s': must be 1-64 letters, numbers or any of +=,.@_-", role)
+	}
+	return nil
+}
+
+func (aws) ValidateKMSKey(key string) error {
+	if !awsKMSKeyPattern.MatchString(key) {
+		return fmt.Errorf("invalid KMS key '%s': must be a key or alias ARN, e.g. arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", key)
+	}
+	return nil
+}
+
+func (a aws) RenderAccess(format string, p AccessParams) (string, error) {
+	switch format {
+	case "cloudformation":
+		return a.renderCloudFormation(p)
+	case "terraform":
+		// Policies are embedded in heredocs, indented to match the closing marker
+		data := awsTemplateData{AccessParams: p}
+		var err error
+		if data.TrustPolicy, err = indentJSON(a.trustPolicy(p), "    "); err != nil {
+			return "", err
+		}
+		if data.PermissionPolicy, err = indentJSON(a.permissionPolicy(p), "    "); err != nil {
+			return "", err
+		}
+		return executeTemplate(awsTemplates, "aws-terraform", data)
+	case "json-policy":
+		return renderJSON(struct {
+			TrustPolicy      iamPolicy `json:"trustPolicy"`
+			PermissionPolicy iamPolicy `json:"permissionPolicy"`
+		}{a.trustPolicy(p), a.permissionPolicy(p)})
+	case "key-policy":
+		return a.renderKeyPolicy(p)
+	}
+	return "", fmt.Errorf("unsupported format '%s' for aws", format)
+}
+
+func (aws) FixHint(bucketName, role, check string) string {
+	objects := "arn:aws:s3:::" + bucketName + "/*"
+	switch check {
+	case CheckAssumeRole:
+		return fmt.Sprintf("Allow the NStream service role to call sts:AssumeRole on '%s' in its trust policy, with the external ID as sts:ExternalId", role)
+	case CheckList:
+		return fmt.Sprintf("Grant '%s' s3:ListBucket on arn:aws:s3:::%s", role, bucketName)
+	case CheckGet:
+		return fmt.Sprintf("Grant '%s' s3:GetObject on %s", role, objects)
+	case CheckPut:
+		return fmt.Sprintf("Grant '%s' s3:PutObject on %s", role, objects)
+	case CheckDelete:
+		return fmt.Sprintf("Grant '%s' s3:DeleteObject on %s", role, objects)
+	case CheckKMSDecrypt:
+		return fmt.Sprintf("Allow '%s' kms:Decrypt and kms:GenerateDataKey in the policy of the bucket's KMS key", role)
+	case CheckKMSKey:
+		return fmt.Sprintf("Allow '%s' %s in the key policy and check that the key is enabled", role, strings.Join(awsKMSActions, ", "))
+	}
+	return ""
+}
+
+// trustPolicy lets the NStream service role assume the bucket access role
+func (aws) trustPolicy(p AccessParams) iamPolicy {
+	statement := iamStatement{
+		Effect:    "Allow",
+		Principal: map[string]string{"AWS": p.ServiceRole},
+		Action:    "sts:AssumeRole",
+	}
+	if p.ExternalID != "" {
+		statement.Condition = map[string]map[string]string{
+			"StringEquals": {"sts:ExternalId": p.ExternalID},
+		}
+	}
+
+	return iamPolicy{Version: "2012-10-17", Statement: []iamStatement{statement}}
+}
+
+// permissionPolicy grants the bucket access role read and write access to the bucket and
+// the use of its KMS key
+func (aws) permissionPolicy(p AccessParams) iamPolicy {
+	policy := s3PermissionPolicy(p)
+	if p.KMSKey == "" {
+		return policy
+	}
+
+	statement := iamStatement{Sid: "NStreamUseBucketKey", Effect: "Allow", Action: awsKMSActions, Resource: p.KMSKey}
+
+	// IAM policies cannot name keys by alias ARN, only match on the alias
+	if m := awsKMSKeyPattern.FindStringSubmatch(p.KMSKey); m != nil && strings.HasPrefix(m[3], "alias/") {
+		statement.Resource = "*"
+		statement.Condition = map[string]map[string]string{
+			"ForAnyValue:StringEquals": {"kms:ResourceAliases": m[3]},
+		}
+	}
+	policy.Statement = append(policy.Statement, statement)
+	return policy
+}
+
+// renderKeyPolicy renders the key policy statement that lets the bucket access role use
+// the customer-managed key
+func (aws) renderKeyPolicy(p AccessParams) (string, error) {
+	if p.KMSKey == "" {
+		return "", fmt.Errorf("a KMS key is required to render the key-policy")
+	}
+
+	// The role is in the key's account, which is the only one its key policy can delegate to
+	m := awsKMSKeyPattern.FindStringSubmatch(p.KMSKey)
+	roleARN := fmt.Sprintf("arn:%s:iam::%s:role/%s", m[1], m[2], p.Role)
+	return renderJSON(struct {
+		KeyPolicyStatement iamStatement `json:"keyPolicyStatement"`
+	}{iamStatement{
+		Sid:       "NStreamUseBucketKey",
+		Effect:    "Allow",
+		Principal: map[string]string{"AWS": roleARN},
+		Action:    awsKMSActions,
+		Resource:  "*",
+	}})
+}
+
+// renderCloudFormation renders a CloudFormation stack that creates the bucket access role
+func (a aws) renderCloudFormation(p AccessParams) (string, error) {
+	type policy struct {
+		PolicyName     string    `json:"PolicyName"`
+		PolicyDocument iamPolicy `json:"PolicyDocument"`
+	}
+	type roleProperties struct {
+		RoleName                 string    `json:"RoleName"`
+		Description              string    `json:"Description"`
+		AssumeRolePolicyDocument iamPolicy `json:"AssumeRolePolicyDocument"`
+		Policies                 []policy  `json:"Policies"`
+	}
+	type resource struct {
+		Type       string         `json:"Type"`
+		Properties roleProperties `json:"Properties"`
+	}
+	type output struct {
+		Description string            `json:"Description"`
+		Value       map[string]string `json:"Value"`
+	}
+
+	return renderJSON(struct {
+		AWSTemplateFormatVersion string              `json:"AWSTemplateFormatVersion"`
+		Description              string              `json:"Description"`
+		Resources                map[string]resource `json:"Resources"`
+		Outputs                  map[string]output   `json:"Outputs"`
+	}{
+		AWSTemplateFormatVersion: "2010-09-09",
+		Description: fmt.Sprintf("Grants NStream access to the S3 bucket '%s' through the IAM role '%s'. Deploy with --capabilities CAPABILITY_NAMED_IAM.",
+			p.Bucket, p.Role),
+		Resources: map[string]resource{
+			"NStreamBucketAccessRole": {
+				Type: "AWS::IAM::Role",
+				Properties: roleProperties{
+					RoleName:                 p.Role,
+					Description:              "Assumed by NStream to read and write the bucket " + p.Bucket,
+					AssumeRolePolicyDocument: a.trustPolicy(p),
+					Policies: []policy{
+						{PolicyName: "nstream-bucket-access", PolicyDocument: a.permissionPolicy(p)},
+					},
+				},
+			},
+		},
+		Outputs: map[string]output{
+			"RoleArn": {
+				Description: "ARN of the bucket access role",
+				Value:       map[string]string{"Fn::GetAtt": "NStreamBucketAccessRole.Arn"},
+			},
+		},
+	})
+}
+
+// validateS3Name follows the S3 general purpose bucket naming rules, which S3-compatible
+// servers follow too, some more loosely
+func validateS3Name(name string) error {
+	switch {
+	case len(name) < 3 || len(name) > 63:
+		return fmt.Errorf("invalid bucket name '%s': must be 3-63 characters long", name)
+	case !awsNamePattern.MatchString(name):
+		return fmt.Errorf("invalid bucket name '%s': only lowercase letters, numbers, dots and hyphens are allowed, starting and ending with a letter or number", name)
+	case strings.Contains(name, ".."):
+		return fmt.Errorf("invalid bucket name '%s': must not contain two adjacent dots", name)
+	case net.ParseIP(name) != nil:
+		return fmt.Errorf("invalid bucket name '%s': must not be formatted as an IP address", name)
+	case strings.HasPrefix(name, "xn--") || strings.HasPrefix(name, "sthree-"):
+		return fmt.Errorf("invalid bucket name '%s': must not start with 'xn--' or 'sthree-'", name)
+	case strings.HasSuffix(name, "-s3alias") || strings.HasSuffix(name, "--ol-s3"):
+		return fmt.Errorf("invalid bucket name '%s': must not end with '-s3alias' or '--ol-s3'", name)
+	}
+	return nil
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	azureNamePattern    = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*[a-z0-9]$`)
+	azureRolePattern    = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{2,127}$`)
+	azureAccountPattern = regexp.MustCompile(`^[a-z0-9]{3,24}$`)
+	azureGroupPattern   = regexp.MustCompile(`^[\w.()-]{0,89}[\w()-]$`)
+	azureUUIDPattern    = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	azureKMSKeyPattern  = regexp.MustCompile(`^https://([a-zA-Z0-9-]{3,24})\.vault\.azure\.net/keys/([a-zA-Z0-9-]{1,127})(/[0-9a-f]{32})?$`)
+)
+
+const (
+	azureBlobRole       = "Storage Blob Data Contributor"
+	azureBlobRoleID     = "ba92f5b4-2d11-453d-a403-e96b0029c9fe"
+	azureKMSRole        = "Key Vault Crypto Service Encryption User"
+	azureTokenAudience  = "api://AzureADTokenExchange"
+	azureCredentialName = "nstream"
+)
+
+var azureTemplates = parseTemplates(`
+{{- define "azure-terraform" -}}
+# Grants NStream access to the blob container '{{.Bucket}}' through the managed identity
+# '{{.Role}}', which trusts the NStream workload identity.
+# Apply with: terraform init && terraform apply
+
+{{tfVariable "resource_group_name" .ResourceGroup}}
+
+{{tfVariable "storage_account_name" .StorageAccount}}
+
+data "azurerm_storage_account" "bucket" {
+  name                = var.storage_account_name
+  resource_group_name = var.resource_group_name
+}
+
+resource "azurerm_user_assigned_identity" "nstream_bucket_access" {
+  name                = "{{.Role}}"
+  resource_group_name = var.resource_group_name
+  location            = data.azurerm_storage_account.bucket.location
+}
+
+resource "azurerm_federated_identity_credential" "nstream" {
+  name                = "{{.CredentialName}}"
+  resource_group_name = var.resource_group_name
+  parent_id           = azurerm_user_assigned_identity.nstream_bucket_access.id
+  audience            = ["{{.TokenAudience}}"]
+  issuer              = "{{.Issuer}}"
+  subject             = "{{.ServiceRole}}"
+}
+
+resource "azurerm_role_assignment" "nstream_bucket_access" {
+  scope                = "${data.azurerm_storage_account.bucket.id}/blobServices/default/containers/{{.Bucket}}"
+  role_definition_name = "{{.BlobRole}}"
+  principal_id         = azurerm_user_assigned_identity.nstream_bucket_access.principal_id
+}
+
+output "client_id" {
+  value = azurerm_user_assigned_identity.nstream_bucket_access.client_id
+}
+{{end}}
+
+{{- define "azure-bicep" -}}
+// Grants NStream access to the blob container '{{.Bucket}}' through the managed identity
+// '{{.Role}}', which trusts the NStream workload identity.
+// Deploy into the resource group of the storage account with:
+//   az deployment group create --resource-group <group> --template-file <file>
+
+param storageAccountName string{{if .StorageAccount}} = '{{.StorageAccount}}'{{end}}
+param containerName string = '{{.Bucket}}'
+param identityName string = '{{.Role}}'
+param location string = resourceGroup().location
+
+var blobDataContributor = subscriptionResourceId('Microsoft.Authorization/roleDefinitions', '{{.BlobRoleID}}')
+
+resource storageAccount 'Microsoft.Storage/storageAccounts@2023-01-01' existing = {
+  name: storageAccountName
+
+  resource blobService 'blobServices' existing = {
+    name: 'default'
+
+    resource container 'containers' existing = {
+      name: containerName
+    }
+  }
+}
+
+resource identity 'Microsoft.ManagedIdentity/userAssignedIdentities@2023-01-31' = {
+  name: identityName
+  location: location
+}
+
+resource nstreamCredential 'Microsoft.ManagedIdentity/userAssignedIdentities/federatedIdentityCredentials@2023-01-31' = {
+  parent: identity
+  name: '{{.CredentialName}}'
+  properties: {
+    audiences: [
+      '{{.TokenAudience}}'
+    ]
+    issuer: '{{.Issuer}}'
+    subject: '{{.ServiceRole}}'
+  }
+}
+
+resource bucketAccess 'Microsoft.Authorization/roleAssignments@2022-04-01' = {
+  name: guid(storageAccount::blobService::container.id, identity.id, blobDataContributor)
+  scope: storageAccount::blobService::container
+  properties: {
+    roleDefinitionId: blobDataContributor
+    principalId: identity.properties.principalId
+    principalType: 'ServicePrincipal'
+  }
+}
+
+output clientId string = identity.properties.clientId
+{{end}}
+
+{{- define "azure-key-policy" -}}
+#!/bin/sh
+# Lets the storage account of the blob container '{{.Bucket}}' encrypt it with the
+# customer-managed key '{{.KMSKey}}'.
+# The storage account needs a system-assigned managed identity.
+set -eu
+
+{{if .ResourceGroup -}}
+RESOURCE_GROUP="{{.ResourceGroup}}"
+{{- else -}}
+RESOURCE_GROUP="${RESOURCE_GROUP:?set RESOURCE_GROUP to the resource group of the storage account}"
+{{- end}}
+{{if .StorageAccount -}}
+STORAGE_ACCOUNT="{{.StorageAccount}}"
+{{- else -}}
+STORAGE_ACCOUNT="${STORAGE_ACCOUNT:?set STORAGE_ACCOUNT to the storage account of the container}"
+{{- end}}
+
+PRINCIPAL_ID="$(az storage account show --name "$STORAGE_ACCOUNT" --resource-group "$RESOURCE_GROUP" --query identity.principalId --output tsv)"
+KEY_SCOPE="$(az keyvault show --name "{{.KeyVault}}" --query id --output tsv)/keys/{{.KeyName}}"
+
+az role assignment create \
+    --assignee-object-id "$PRINCIPAL_ID" \
+    --assignee-principal-type ServicePrincipal \
+    --role "{{.KMSRole}}" \
+    --scope "$KEY_SCOPE"
+{{end}}
+`)
+
+// azureTemplateData is what the azure access templates are rendered from
+type azureTemplateData struct {
+	AccessParams
+
+	BlobRole       string
+	BlobRoleID     string
+	TokenAudience  string
+	CredentialName string
+
+	// KMSRole, KeyVault and KeyName are used by the key-policy script
+	KMSRole  string
+	KeyVault string
+	KeyName  string
+}
+
+// azure is Microsoft Azure: blob containers accessed through a managed identity that trusts
+// the NStream workload identity
+type azure struct{}
+
+func init() {
+	Register(azure{})
+}
+
+func (azure) Name() string          { return "azure" }
+func (azure) DisplayName() string   { return "Azure" }
+func (azure) RoleType() string      { return "Managed Identity" }
+func (azure) DefaultRole() string   { return DefaultAccessRole }
+func (azure) DefaultRegion() string { return "" }
+func (azure) UsesServiceRole() bool { return true }
+
+func (azure) StorageClasses() []string {
+	return []string{"Hot", "Cool", "Cold", "Archive"}
+}
+
+func (azure) AccessFormats() []string {
+	return []string{"bicep", "terraform", "json-policy", "key-policy"}
+}
+
+// ValidateBucketName follows the Blob Storage container naming rules
+func (azure) ValidateBucketName(name string) error {
+	switch {
+	case len(name) < 3 || len(name) > 63:
+		return fmt.Errorf("invalid bucket name '%s': must be 3-63 characters long", name)
+	case !azureNamePattern.MatchString(name):
+		return fmt.Errorf("invalid bucket name '%s': only lowercase letters, numbers and hyphens are allowed, starting and ending with a letter or number", name)
+	case strings.Contains(name, "--"):
+		return fmt.Errorf("invalid bucket name '%s': must not contain consecutive hyphens", name)
+	}
+	return nil
+}
+
+func (azure) ValidateRole(role string) error {
+	if !azureRolePattern.MatchString(role) {
+		return fmt.Errorf("invalid managed identity name '%s': must be 3-128 letters, numbers, hyphens and underscores, starting with a letter or number", role)
+	}
+	return nil
+}
+
+func (azure) ValidateKMSKey(key string) error {
+	if !azureKMSKeyPattern.MatchString(key) {
+		return fmt.Errorf("invalid KMS key '%s': must be a Key Vault key URL, e.g. https://my-vault.vault.azure.net/keys/my-key", key)
+	}
+	return nil
+}
+
+func (a azure) RenderAccess(format string, p AccessParams) (string, error) {
+	// The federated credential cannot be created without the issuer of NStream's tokens
+	if p.Issuer == "" {
+		return "", fmt.Errorf("NStream service role for azure has no issuer")
+	}
+
+	data := azureTemplateData{
+		AccessParams:   p,
+		BlobRole:       azureBlobRole,
+		BlobRoleID:     azureBlobRoleID,
+		TokenAudience:  azureTokenAudience,
+		CredentialName: azureCredentialName,
+		KMSRole:        azureKMSRole,
+	}
+
+	switch format {
+	case "bicep", "terraform":
+		return executeTemplate(azureTemplates, "azure-"+format, data)
+	case "json-policy":
+		return a.renderJSONPolicy(p)
+	case "key-policy":
+		// Only the storage account uses the key, so its managed identity is granted the key
+		if p.KMSKey == "" {
+			return "", fmt.Errorf("a KMS key is required to render the key-policy")
+		}
+		m := azureKMSKeyPattern.FindStringSubmatch(p.KMSKey)
+		data.KeyVault, data.KeyName = m[1], m[2]
+		return executeTemplate(azureTemplates, "azure-key-policy", data)
+	}
+	return "", fmt.Errorf("unsupported format '%s' for azure", format)
+}
+
+func (azure) FixHint(bucketName, role, check string) string {
+	switch check {
+	case CheckAssumeRole:
+		return fmt.Sprintf("Add a federated credential trusting the NStream workload identity to the managed identity '%s'", role)
+	case CheckList, CheckGet, CheckPut, CheckDelete:
+		return fmt.Sprintf("Assign '%s' the %s role on the container '%s'", role, azureBlobRole, bucketName)
+	case CheckKMSDecrypt:
+		return "Grant the storage account's identity get, wrapKey and unwrapKey on the customer-managed key in Key Vault"
+	case CheckKMSKey:
+		return fmt.Sprintf("Assign the storage account's managed identity the %s role on the key and check that it is enabled", azureKMSRole)
+	}
+	return ""
+}
+
+// renderJSONPolicy renders the managed identity's federated credential and its role
+// assignment on the container
+func (azure) renderJSONPolicy(p AccessParams) (string, error) {
+	if p.Subscription == "" || p.ResourceGroup == "" || p.StorageAccount == "" {
+		return "", fmt.Errorf("a subscription, resource group and storage account are required to render the azure json-policy")
+	}
+
+	// The container is the scope of the role assignment
+	scope := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/blobServices/default/containers/%s",
+		p.Subscription, p.ResourceGroup, p.StorageAccount, p.Bucket)
+
+	return renderJSON(struct {
+		ManagedIdentity             string                 `json:"managedIdentity"`
+		FederatedIdentityCredential map[string]interface{} `json:"federatedIdentityCredential"`
+		RoleAssignment              map[string]string      `json:"roleAssignment"`
+	}{
+		ManagedIdentity: p.Role,
+		FederatedIdentityCredential: map[string]interface{}{
+			"name":      azureCredentialName,
+			"issuer":    p.Issuer,
+			"subject":   p.ServiceRole,
+			"audiences": []string{azureTokenAudience},
+		},
+		RoleAssignment: map[string]string{
+			"roleDefinitionName": azureBlobRole,
+			"roleDefinitionId":   azureBlobRoleID,
+			"scope":              scope,
+		},
+	})
+}
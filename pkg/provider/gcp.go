@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+)
+
+var (
+	gcpNamePattern    = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*[a-z0-9]$`)
+	gcpRolePattern    = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+	gcpProjectPattern = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+	gcpKMSKeyPattern  = regexp.MustCompile(`^projects/([a-z][a-z0-9-]{4,28}[a-z0-9])/locations/[a-z0-9-]+/keyRings/[\w-]{1,63}/cryptoKeys/[\w-]{1,63}$`)
+)
+
+// Roles granted to the bucket access service account on the bucket
+var gcpBucketRoles = []string{"roles/storage.objectAdmin", "roles/storage.legacyBucketReader"}
+
+// Roles granted to the NStream service account on the bucket access service account, and to
+// the Cloud Storage service agent on customer-managed keys
+const (
+	gcpImpersonationRole = "roles/iam.serviceAccountTokenCreator"
+	gcpKMSRole           = "roles/cloudkms.cryptoKeyEncrypterDecrypter"
+)
+
+var gcpTemplates = parseTemplates(`
+{{- define "gcp-terraform" -}}
+# Grants NStream access to the Cloud Storage bucket '{{.Bucket}}' through the service
+# account '{{.Role}}', which the NStream service account impersonates.
+# Apply with: terraform init && terraform apply
+
+{{tfVariable "project" .Project}}
+
+resource "google_service_account" "nstream_bucket_access" {
+  project      = var.project
+  account_id   = "{{.Role}}"
+  display_name = "NStream bucket access"
+}
+
+resource "google_service_account_iam_member" "nstream_impersonation" {
+  service_account_id = google_service_account.nstream_bucket_access.name
+  role               = "{{.ImpersonationRole}}"
+  member             = "serviceAccount:{{.ServiceRole}}"
+}
+
+resource "google_storage_bucket_iam_member" "nstream_bucket_access" {
+  for_each = toset([{{quoteList .BucketRoles}}])
+  bucket   = "{{.Bucket}}"
+  role     = each.value
+  member   = "serviceAccount:${google_service_account.nstream_bucket_access.email}"
+}
+
+output "service_account" {
+  value = google_service_account.nstream_bucket_access.email
+}
+{{end}}
+
+{{- define "gcp-gcloud-script" -}}
+#!/bin/sh
+# Grants NStream access to the Cloud Storage bucket '{{.Bucket}}' through the service
+# account '{{.Role}}', which the NStream service account impersonates.
+set -eu
+
+{{if .Project -}}
+PROJECT="{{.Project}}"
+{{- else -}}
+PROJECT="${PROJECT:-$(gcloud config get-value project)}"
+{{- end}}
+SERVICE_ACCOUNT="{{.Role}}@${PROJECT}.iam.gserviceaccount.com"
+
+gcloud iam service-accounts create "{{.Role}}" \
+    --project "$PROJECT" \
+    --display-name "NStream bucket access"
+
+gcloud iam service-accounts add-iam-policy-binding "$SERVICE_ACCOUNT" \
+    --project "$PROJECT" \
+    --member "serviceAccount:{{.ServiceRole}}" \
+    --role "{{.ImpersonationRole}}"
+{{range .BucketRoles}}
+gcloud storage buckets add-iam-policy-binding "gs://{{$.Bucket}}" \
+    --member "serviceAccount:$SERVICE_ACCOUNT" \
+    --role "{{.}}"
+{{end -}}
+{{end}}
+
+{{- define "gcp-key-policy" -}}
+#!/bin/sh
+# Lets Cloud Storage encrypt objects in the bucket '{{.Bucket}}' with the customer-managed
+# key '{{.KMSKey}}'.
+set -eu
+
+{{if .Project -}}
+PROJECT="{{.Project}}"
+{{- else -}}
+PROJECT="${PROJECT:-$(gcloud config get-value project)}"
+{{- end}}
+SERVICE_AGENT="$(gcloud storage service-agent --project "$PROJECT")"
+
+gcloud kms keys add-iam-policy-binding "{{.KMSKey}}" \
+    --member "serviceAccount:$SERVICE_AGENT" \
+    --role "{{.KMSRole}}"
+{{end}}
+`)
+
+// gcpTemplateData is what the gcp access templates are rendered from
+type gcpTemplateData struct {
+	AccessParams
+
+	ImpersonationRole string
+	BucketRoles       []string
+	KMSRole           string
+}
+
+// gcpPolicy is a set of Google Cloud IAM policy bindings
+type gcpPolicy struct {
+	Bindings []gcpBinding `json:"bindings"`
+}
+
+type gcpBinding struct {
+	Role    string   `json:"role"`
+	Members []string `json:"members"`
+}
+
+// gcp is Google Cloud: Cloud Storage buckets accessed through a service account that the
+// NStream service account impersonates
+type gcp struct{}
+
+func init() {
+	Register(gcp{})
+}
+
+func (gcp) Name() string          { return "gcp" }
+func (gcp) DisplayName() string   { return "GCP" }
+func (gcp) RoleType() string      { return "Service Account" }
+func (gcp) DefaultRole() string   { return DefaultAccessRole }
+func (gcp) DefaultRegion() string { return "" }
+func (gcp) UsesServiceRole() bool { return true }
+
+func (gcp) StorageClasses() []string {
+	return []string{"STANDARD", "NEARLINE", "COLDLINE", "ARCHIVE"}
+}
+
+func (gcp) AccessFormats() []string {
+	return []string{"gcloud-script", "terraform", "json-policy", "key-policy"}
+}
+
+// ValidateBucketName follows the Cloud Storage bucket naming rules
+func (gcp) ValidateBucketName(name string) error {
+	maxLength := 63
+	if strings.Contains(name, ".") {
+		maxLength = 222
+	}
+
+	switch {
+	case len(name) < 3 || len(name) > maxLength:
+		return fmt.Errorf("invalid bucket name '%s': must be 3-63 characters long (up to 222 with dots)", name)
+	case !gcpNamePattern.MatchString(name):
+		return fmt.Errorf("invalid bucket name '%s': only lowercase letters, numbers, dots, hyphens and underscores are allowed, starting and ending with a letter or number", name)
+	case net.ParseIP(name) != nil:
+		return fmt.Errorf("invalid bucket name '%s': must not be formatted as an IP address", name)
+	case strings.HasPrefix(name, "goog") || strings.Contains(name, "google") || strings.Contains(name, "g00gle"):
+		return fmt.Errorf("invalid bucket name '%s': must not start with 'goog' or contain 'google'", name)
+	}
+
+	for _, part := range strings.Split(name, ".") {
+		if len(part) == 0 || len(part) > 63 {
+			return fmt.Errorf("invalid bucket name '%s': each dot-separated part must be 1-63 characters long", name)
+		}
+	}
+	return nil
+}
+
+func (gcp) ValidateRole(role string) error {
+	if !gcpRolePattern.MatchString(role) {
+		return fmt.Errorf("invalid service account ID '%s': must be 6-30 lowercase letters, numbers and hyphens, starting with a letter", role)
+	}
+	return nil
+}
+
+func (gcp) ValidateKMSKey(key string) error {
+	if !gcpKMSKeyPattern.MatchString(key) {
+		return fmt.Errorf("invalid KMS key '%s': must be a Cloud KMS key name, e.g. projects/my-project/locations/us/keyRings/my-ring/cryptoKeys/my-key", key)
+	}
+	return nil
+}
+
+func (g gcp) RenderAccess(format string, p AccessParams) (string, error) {
+	data := gcpTemplateData{
+		AccessParams:      p,
+		ImpersonationRole: gcpImpersonationRole,
+		BucketRoles:       gcpBucketRoles,
+		KMSRole:           gcpKMSRole,
+	}
+
+	switch format {
+	case "gcloud-script", "terraform":
+		return executeTemplate(gcpTemplates, "gcp-"+format, data)
+	case "json-policy":
+		return g.renderJSONPolicy(p)
+	case "key-policy":
+		// Only the Cloud Storage service agent uses the key, so it is granted the key
+		if p.KMSKey == "" {
+			return "", fmt.Errorf("a KMS key is required to render the key-policy")
+		}
+		return executeTemplate(gcpTemplates, "gcp-key-policy", data)
+	}
+	return "", fmt.Errorf("unsupported format '%s' for gcp", format)
+}
+
+func (gcp) FixHint(bucketName, role, check string) string {
+	switch check {
+	case CheckAssumeRole:
+		return fmt.Sprintf("Grant the NStream service account %s on the service account '%s'", gcpImpersonationRole, role)
+	case CheckList, CheckGet, CheckPut, CheckDelete:
+		return fmt.Sprintf("Grant '%s' roles/storage.objectAdmin on gs://%s", role, bucketName)
+	case CheckKMSDecrypt:
+		return "Grant the Cloud Storage service agent roles/cloudkms.cryptoKeyEncrypterDecrypter on the bucket's default KMS key"
+	case CheckKMSKey:
+		return fmt.Sprintf("Grant the Cloud Storage service agent %s on the key and check that its primary version is enabled", gcpKMSRole)
+	}
+	return ""
+}
+
+// renderJSONPolicy renders the policy bindings on the bucket access service account and
+// on the bucket
+func (gcp) renderJSONPolicy(p AccessParams) (string, error) {
+	if p.Project == "" {
+		return "", fmt.Errorf("a project is required to render the gcp json-policy")
+	}
+
+	serviceAccount := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", p.Role, p.Project)
+	member := "serviceAccount:" + serviceAccount
+	bucketPolicy := gcpPolicy{}
+	for _, role := range gcpBucketRoles {
+		bucketPolicy.Bindings = append(bucketPolicy.Bindings, gcpBinding{Role: role, Members: []string{member}})
+	}
+
+	return renderJSON(struct {
+		ServiceAccount       string    `json:"serviceAccount"`
+		ServiceAccountPolicy gcpPolicy `json:"serviceAccountPolicy"`
+		BucketPolicy         gcpPolicy `json:"bucketPolicy"`
+	}{
+		ServiceAccount: serviceAccount,
+		ServiceAccountPolicy: gcpPolicy{Bindings: []gcpBinding{
+			{Role: gcpImpersonationRole, Members: []string{"serviceAccount:" + p.ServiceRole}},
+		}},
+		BucketPolicy: bucketPolicy,
+	})
+}
@@ -0,0 +1,86 @@
+// Package provider describes the cloud providers NStream creates clusters and buckets on.
+// Each provider lives in its own file and registers itself, so commands look providers up
+// in the registry instead of switching on their names.
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Provider is a cloud provider buckets and clusters can be created on
+type Provider interface {
+	// Name identifies the provider in flags, the config and API requests, e.g. "aws"
+	Name() string
+	// DisplayName is how the provider is shown in menus, e.g. "AWS"
+	DisplayName() string
+
+	// RoleType is what the provider calls the identity NStream accesses buckets through,
+	// e.g. "IAM Role"
+	RoleType() string
+	// DefaultRole is the name of that identity when none is given. It is empty when the
+	// identity is optional.
+	DefaultRole() string
+	// ValidateRole checks the name of the bucket access identity
+	ValidateRole(role string) error
+
+	// DefaultRegion is the region used when none is given, for providers whose regions are
+	// not in the mothership's region catalog. Providers in the catalog return "".
+	DefaultRegion() string
+
+	// StorageClasses lists the storage classes of buckets, default first
+	StorageClasses() []string
+	// ValidateBucketName checks a bucket name against the provider's naming rules
+	ValidateBucketName(name string) error
+	// ValidateKMSKey checks the reference to a customer-managed key
+	ValidateKMSKey(key string) error
+
+	// UsesServiceRole reports whether bucket access is granted to the NStream service role
+	// returned by GetServiceRole
+	UsesServiceRole() bool
+	// AccessFormats lists the formats of the bucket access setup templates, default first
+	AccessFormats() []string
+	// RenderAccess renders the setup template in one of AccessFormats. The parameters have
+	// been validated.
+	RenderAccess(format string, p AccessParams) (string, error)
+	// FixHint describes how to fix a failed bucket access or readiness check
+	FixHint(bucket, role, check string) string
+}
+
+// Bucket access checks, in the order the server runs them. The endpoint check only runs for
+// providers with a custom endpoint.
+const (
+	CheckEndpoint   = "endpoint"
+	CheckAssumeRole = "assume-role"
+	CheckList       = "list"
+	CheckGet        = "get"
+	CheckPut        = "put"
+	CheckDelete     = "delete"
+	CheckKMSDecrypt = "kms-decrypt"
+)
+
+// CheckKMSKey is the resource readiness check that the customer-managed key exists, is
+// enabled and can be used for the bucket
+const CheckKMSKey = "kms-key"
+
+// DefaultAccessRole is the name of the bucket access role when none is given
+const DefaultAccessRole = "nstream-bucket-access"
+
+// DefaultAccessFormat returns the access template format used when none is given
+func DefaultAccessFormat(p Provider) string {
+	if formats := p.AccessFormats(); len(formats) > 0 {
+		return formats[0]
+	}
+	return ""
+}
+
+// ValidateStorageClass checks that the storage class is offered by the provider
+func ValidateStorageClass(p Provider, class string) error {
+	classes := p.StorageClasses()
+	for _, c := range classes {
+		if c == class {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid storage class '%s' for %s (must be one of %s)", class, p.Name(), strings.Join(classes, ", "))
+}
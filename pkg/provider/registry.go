@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// registry holds the registered providers by name
+var registry = map[string]Provider{}
+
+// Register adds a provider to the registry. It is called from the init function of the
+// provider's file and panics if the name is already taken.
+func Register(p Provider) {
+	if _, ok := registry[p.Name()]; ok {
+		panic(fmt.Sprintf("provider %q registered twice", p.Name()))
+	}
+	registry[p.Name()] = p
+}
+
+// Get returns the provider with the given name
+func Get(name string) (Provider, error) {
+	if p, ok := registry[name]; ok {
+		return p, nil
+	}
+	return nil, fmt.Errorf("unsupported cloud provider '%s' (must be one of %s)", name, strings.Join(Names(), ", "))
+}
+
+// All returns the registered providers sorted by name, which is the order menus offer them in
+func All() []Provider {
+	providers := make([]Provider, 0, len(registry))
+	for _, p := range registry {
+		providers = append(providers, p)
+	}
+	sort.Slice(providers, func(i, j int) bool { return providers[i].Name() < providers[j].Name() })
+	return providers
+}
+
+// Names returns the names of the registered providers sorted by name
+func Names() []string {
+	var names []string
+	for _, p := range All() {
+		names = append(names, p.Name())
+	}
+	return names
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+
+	clusterproto "github.com/nstreama-ai/nstream-ai-cli/proto/cluster"
+)
+
+// S3Compatible is the provider of buckets on self-hosted or third-party S3-compatible
+// servers such as MinIO, Cloudflare R2 and Ceph
+const S3Compatible = "s3compatible"
+
+// Ways an s3compatible bucket is accessed with the keys in its credentials secret
+const (
+	CredentialsStatic = "static"
+	CredentialsSTS    = "sts"
+)
+
+var (
+	secretNamePattern         = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]{0,251}[a-z0-9])?$`)
+	s3CompatibleKMSKeyPattern = regexp.MustCompile(`^[\w./-]{1,256}$`)
+)
+
+// s3Compatible is a self-hosted or third-party server implementing the S3 API. NStream uses
+// the keys in the bucket's credentials secret, optionally to assume a role through STS.
+type s3Compatible struct{}
+
+func init() {
+	Register(s3Compatible{})
+}
+
+func (s3Compatible) Name() string        { return S3Compatible }
+func (s3Compatible) DisplayName() string { return "S3-compatible (MinIO, Cloudflare R2, Ceph)" }
+func (s3Compatible) RoleType() string    { return "STS Role" }
+
+// DefaultRole is empty: a role is only assumed with sts credentials
+func (s3Compatible) DefaultRole() string { return "" }
+
+// DefaultRegion is used as most S3-compatible servers accept any region; Cloudflare R2
+// expects "auto"
+func (s3Compatible) DefaultRegion() string { return "us-east-1" }
+
+func (s3Compatible) UsesServiceRole() bool { return false }
+
+// StorageClasses only offers STANDARD, as other classes differ between servers
+func (s3Compatible) StorageClasses() []string {
+	return []string{"STANDARD"}
+}
+
+// AccessFormats only offers json-policy, as NStream uses the keys in the bucket's
+// credentials secret and only their policy is needed
+func (s3Compatible) AccessFormats() []string {
+	return []string{"json-policy"}
+}
+
+// ValidateBucketName follows the S3 rules, which some servers apply more loosely
+func (s3Compatible) ValidateBucketName(name string) error {
+	return validateS3Name(name)
+}
+
+func (s3Compatible) ValidateRole(role string) error {
+	if role != "" && !roleARNPattern.MatchString(role) {
+		return fmt.Errorf("invalid role ARN '%s'", role)
+	}
+	return nil
+}
+
+func (s3Compatible) ValidateKMSKey(key string) error {
+	if !s3CompatibleKMSKeyPattern.MatchString(key) {
+		return fmt.Errorf("invalid KMS key '%s': must be the ID of a key on the storage server", key)
+	}
+	return nil
+}
+
+func (s3Compatible) RenderAccess(format string, p AccessParams) (string, error) {
+	if format != "json-policy" {
+		return "", fmt.Errorf("unsupported format '%s' for %s", format, S3Compatible)
+	}
+
+	// Attached to the user of the access key in the credentials secret, or to the role it
+	// assumes with sts credentials
+	return renderJSON(struct {
+		PermissionPolicy iamPolicy `json:"permissionPolicy"`
+	}{s3PermissionPolicy(p)})
+}
+
+func (s3Compatible) FixHint(bucketName, role, check string) string {
+	principal := "the access key in the credentials secret"
+	if role != "" {
+		principal = "'" + role + "'"
+	}
+	switch check {
+	case CheckEndpoint:
+		return "Check that the endpoint is reachable from NStream, that its TLS certificate is valid and that path-style addressing matches the server"
+	case CheckAssumeRole:
+		return fmt.Sprintf("Allow the access key in the credentials secret to assume '%s' through the STS API", role)
+	case CheckList:
+		return fmt.Sprintf("Grant %s s3:ListBucket on arn:aws:s3:::%s", principal, bucketName)
+	case CheckGet:
+		return fmt.Sprintf("Grant %s s3:GetObject on arn:aws:s3:::%s/*", principal, bucketName)
+	case CheckPut:
+		return fmt.Sprintf("Grant %s s3:PutObject on arn:aws:s3:::%s/*", principal, bucketName)
+	case CheckDelete:
+		return fmt.Sprintf("Grant %s s3:DeleteObject on arn:aws:s3:::%s/*", principal, bucketName)
+	case CheckKMSDecrypt:
+		return "Check the server-side encryption key configured for the bucket on the storage server"
+	case CheckKMSKey:
+		return "Check that the key exists on the storage server's KMS and that the credentials may use it"
+	}
+	return ""
+}
+
+// ValidateEndpoint checks that endpoint is the base URL of an S3 API
+func ValidateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("invalid endpoint '%s': must be an http or https URL, e.g. https://minio.example.com:9000", endpoint)
+	}
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.User != nil {
+		return fmt.Errorf("invalid endpoint '%s': must not contain a path, query or credentials", endpoint)
+	}
+	return nil
+}
+
+// IsInsecureEndpoint reports whether data and signatures would be sent to endpoint unencrypted
+func IsInsecureEndpoint(endpoint string) bool {
+	u, err := url.Parse(endpoint)
+	return err == nil && u.Scheme == "http"
+}
+
+// ValidateS3CompatibleConfig checks the location and credentials of an s3compatible bucket
+func ValidateS3CompatibleConfig(cfg *clusterproto.S3CompatibleConfig) error {
+	if cfg == nil {
+		return fmt.Errorf("s3compatible buckets need an endpoint and a credentials secret")
+	}
+	if err := ValidateEndpoint(cfg.Endpoint); err != nil {
+		return err
+	}
+	if !secretNamePattern.MatchString(cfg.CredentialsSecret) {
+		return fmt.Errorf("invalid credentials secret name '%s': must be lowercase letters, numbers, dots and hyphens", cfg.CredentialsSecret)
+	}
+
+	switch cfg.Credentials {
+	case CredentialsStatic:
+		if cfg.RoleArn != "" || cfg.StsEndpoint != "" {
+			return fmt.Errorf("a role ARN and STS endpoint are only used with sts credentials")
+		}
+	case CredentialsSTS:
+		if !roleARNPattern.MatchString(cfg.RoleArn) {
+			return fmt.Errorf("invalid role ARN '%s': sts credentials need the ARN of the role to assume, e.g. arn:minio:iam:::role/nstream", cfg.RoleArn)
+		}
+		if cfg.StsEndpoint != "" {
+			if err := ValidateEndpoint(cfg.StsEndpoint); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("invalid credentials type '%s' (must be %s or %s)", cfg.Credentials, CredentialsStatic, CredentialsSTS)
+	}
+	return nil
+}
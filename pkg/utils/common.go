@@ -3,6 +3,11 @@ package utils
 // ANSI color codes
 const (
 	RedColor     = "\033[31m"
+	GreenColor   = "\033[32m"
+	YellowColor  = "\033[33m"
+	BlueColor    = "\033[34m"
+	MagentaColor = "\033[35m"
+	CyanColor    = "\033[36m"
 	BoldColor    = "\033[1m"
 	ResetColor   = "\033[0m"
 	BlinkColor   = "\033[5m"
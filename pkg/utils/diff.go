@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"os"
+	"strings"
+)
+
+// ColoredDiff returns a line-by-line diff of before and after, with removed
+// lines prefixed by "-" and added lines prefixed by "+". The lines are colored
+// red and green only when stdout is a terminal.
+func ColoredDiff(before, after string) string {
+	red, green, reset := RedColor, GreenColor, ResetColor
+	if !IsTerminal(os.Stdout) {
+		red, green, reset = "", "", ""
+	}
+
+	a := strings.Split(strings.TrimRight(before, "\n"), "\n")
+	b := strings.Split(strings.TrimRight(after, "\n"), "\n")
+
+	// Longest common subsequence table, filled from the end
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var sb strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			sb.WriteString("  " + a[i] + "\n")
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			sb.WriteString(red + "- " + a[i] + reset + "\n")
+			i++
+		default:
+			sb.WriteString(green + "+ " + b[j] + reset + "\n")
+			j++
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	progressBarWidth  = 30
+	progressRedrawGap = 100 * time.Millisecond
+)
+
+// ProgressBar renders a single-line progress bar for a byte transfer. Add may be called from
+// several goroutines, e.g. by parallel multipart uploads.
+type ProgressBar struct {
+	mu      sync.Mutex
+	w       io.Writer
+	label   string
+	total   int64
+	current int64
+	start   time.Time
+	drawn   time.Time
+}
+
+// NewProgressBar creates a progress bar for a transfer of total bytes; total may be 0 if unknown
+func NewProgressBar(w io.Writer, label string, total int64) *ProgressBar {
+	p := &ProgressBar{
+		w:     w,
+		label: label,
+		total: total,
+		start: time.Now(),
+	}
+	p.draw()
+	return p
+}
+
+// SetTotal sets the size of the transfer once it is known
+func (p *ProgressBar) SetTotal(total int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.total = total
+	p.draw()
+}
+
+// Add records n more transferred bytes
+func (p *ProgressBar) Add(n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.current += n
+	if time.Since(p.drawn) >= progressRedrawGap {
+		p.draw()
+	}
+}
+
+// Finish draws the final state and moves to the next line
+func (p *ProgressBar) Finish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.draw()
+	fmt.Fprintln(p.w)
+}
+
+// draw must be called with mu held
+func (p *ProgressBar) draw() {
+	p.drawn = time.Now()
+
+	rate := ""
+	if elapsed := time.Since(p.start).Seconds(); elapsed > 0 {
+		rate = FormatBytes(int64(float64(p.current)/elapsed)) + "/s"
+	}
+
+	if p.total <= 0 {
+		fmt.Fprintf(p.w, "\r%s  %s  %s\033[K", p.label, FormatBytes(p.current), rate)
+		return
+	}
+
+	filled := int(float64(progressBarWidth) * float64(p.current) / float64(p.total))
+	if filled > progressBarWidth {
+		filled = progressBarWidth
+	}
+	bar := strings.Repeat("=", filled) + strings.Repeat(" ", progressBarWidth-filled)
+	percent := 100 * float64(p.current) / float64(p.total)
+
+	fmt.Fprintf(p.w, "\r%s [%s] %3.0f%%  %s/%s  %s\033[K", p.label, bar, percent, FormatBytes(p.current), FormatBytes(p.total), rate)
+}
+
+// FormatBytes renders a byte count with a binary unit, e.g. 1.5Gi
+func FormatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ci", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParseSince parses a relative duration (e.g. 1h) or an absolute RFC 3339 timestamp
+func ParseSince(value string) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		if d < 0 {
+			return time.Time{}, fmt.Errorf("duration %q must be positive", value)
+		}
+		return time.Now().Add(-d), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a duration (e.g. 1h) or RFC 3339 timestamp", value)
+	}
+	return t, nil
+}
@@ -9,6 +9,7 @@ package cluster
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -24,8 +25,18 @@ const (
 
 // Cluster messages
 type ListClustersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AuthToken     string                 `protobuf:"bytes,1,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AuthToken string                 `protobuf:"bytes,1,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	// selector only returns clusters whose labels match
+	Selector *LabelSelector `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	// page_size caps the number of clusters returned; the server picks a default when 0
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a previous listing from its next_page_token
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// filter is a server-side expression on Cluster fields, such as "phase=ready AND region=us-east-1"
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// order_by is a comma-separated list of fields, each optionally followed by " desc"
+	OrderBy       string `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -67,16 +78,159 @@ func (x *ListClustersRequest) GetAuthToken() string {
 	return ""
 }
 
-type ListClustersResponse struct {
+func (x *ListClustersRequest) GetSelector() *LabelSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *ListClustersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListClustersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListClustersRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListClustersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+// LabelSelector selects resources whose labels match every requirement
+type LabelSelector struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Clusters      []*Cluster             `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Requirements  []*LabelRequirement    `protobuf:"bytes,1,rep,name=requirements,proto3" json:"requirements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LabelSelector) Reset() {
+	*x = LabelSelector{}
+	mi := &file_proto_cluster_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LabelSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelSelector) ProtoMessage() {}
+
+func (x *LabelSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelSelector.ProtoReflect.Descriptor instead.
+func (*LabelSelector) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LabelSelector) GetRequirements() []*LabelRequirement {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+type LabelRequirement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// operator is one of "=", "!=", "in", "notin", "exists" or "!exists"
+	Operator      string   `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Values        []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LabelRequirement) Reset() {
+	*x = LabelRequirement{}
+	mi := &file_proto_cluster_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LabelRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelRequirement) ProtoMessage() {}
+
+func (x *LabelRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelRequirement.ProtoReflect.Descriptor instead.
+func (*LabelRequirement) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LabelRequirement) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LabelRequirement) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *LabelRequirement) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ListClustersResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Clusters []*Cluster             `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	// next_page_token is empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListClustersResponse) Reset() {
 	*x = ListClustersResponse{}
-	mi := &file_proto_cluster_proto_msgTypes[1]
+	mi := &file_proto_cluster_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -88,7 +242,7 @@ func (x *ListClustersResponse) String() string {
 func (*ListClustersResponse) ProtoMessage() {}
 
 func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[1]
+	mi := &file_proto_cluster_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -101,7 +255,7 @@ func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListClustersResponse.ProtoReflect.Descriptor instead.
 func (*ListClustersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{1}
+	return file_proto_cluster_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListClustersResponse) GetClusters() []*Cluster {
@@ -111,6 +265,13 @@ func (x *ListClustersResponse) GetClusters() []*Cluster {
 	return nil
 }
 
+func (x *ListClustersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type Cluster struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -118,13 +279,19 @@ type Cluster struct {
 	CloudProvider string                 `protobuf:"bytes,3,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
 	Bucket        string                 `protobuf:"bytes,4,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Phase         string                 `protobuf:"bytes,6,opt,name=phase,proto3" json:"phase,omitempty"`
+	Type          string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	Replicas      int32                  `protobuf:"varint,8,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	Size          string                 `protobuf:"bytes,9,opt,name=size,proto3" json:"size,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,11,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Cluster) Reset() {
 	*x = Cluster{}
-	mi := &file_proto_cluster_proto_msgTypes[2]
+	mi := &file_proto_cluster_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -136,7 +303,7 @@ func (x *Cluster) String() string {
 func (*Cluster) ProtoMessage() {}
 
 func (x *Cluster) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[2]
+	mi := &file_proto_cluster_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -149,7 +316,7 @@ func (x *Cluster) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cluster.ProtoReflect.Descriptor instead.
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{2}
+	return file_proto_cluster_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Cluster) GetId() string {
@@ -187,6 +354,48 @@ func (x *Cluster) GetRole() string {
 	return ""
 }
 
+func (x *Cluster) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *Cluster) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Cluster) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *Cluster) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *Cluster) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Cluster) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
 type VerifyClusterExistsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
@@ -197,7 +406,7 @@ type VerifyClusterExistsRequest struct {
 
 func (x *VerifyClusterExistsRequest) Reset() {
 	*x = VerifyClusterExistsRequest{}
-	mi := &file_proto_cluster_proto_msgTypes[3]
+	mi := &file_proto_cluster_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -209,7 +418,7 @@ func (x *VerifyClusterExistsRequest) String() string {
 func (*VerifyClusterExistsRequest) ProtoMessage() {}
 
 func (x *VerifyClusterExistsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[3]
+	mi := &file_proto_cluster_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -222,7 +431,7 @@ func (x *VerifyClusterExistsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyClusterExistsRequest.ProtoReflect.Descriptor instead.
 func (*VerifyClusterExistsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{3}
+	return file_proto_cluster_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *VerifyClusterExistsRequest) GetClusterName() string {
@@ -249,7 +458,7 @@ type VerifyClusterExistsResponse struct {
 
 func (x *VerifyClusterExistsResponse) Reset() {
 	*x = VerifyClusterExistsResponse{}
-	mi := &file_proto_cluster_proto_msgTypes[4]
+	mi := &file_proto_cluster_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -261,7 +470,7 @@ func (x *VerifyClusterExistsResponse) String() string {
 func (*VerifyClusterExistsResponse) ProtoMessage() {}
 
 func (x *VerifyClusterExistsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[4]
+	mi := &file_proto_cluster_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,7 +483,7 @@ func (x *VerifyClusterExistsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyClusterExistsResponse.ProtoReflect.Descriptor instead.
 func (*VerifyClusterExistsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{4}
+	return file_proto_cluster_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *VerifyClusterExistsResponse) GetExists() bool {
@@ -301,7 +510,7 @@ type GetClusterDetailsRequest struct {
 
 func (x *GetClusterDetailsRequest) Reset() {
 	*x = GetClusterDetailsRequest{}
-	mi := &file_proto_cluster_proto_msgTypes[5]
+	mi := &file_proto_cluster_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -313,7 +522,7 @@ func (x *GetClusterDetailsRequest) String() string {
 func (*GetClusterDetailsRequest) ProtoMessage() {}
 
 func (x *GetClusterDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[5]
+	mi := &file_proto_cluster_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,7 +535,7 @@ func (x *GetClusterDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClusterDetailsRequest.ProtoReflect.Descriptor instead.
 func (*GetClusterDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{5}
+	return file_proto_cluster_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetClusterDetailsRequest) GetClusterName() string {
@@ -353,7 +562,7 @@ type GetClusterDetailsResponse struct {
 
 func (x *GetClusterDetailsResponse) Reset() {
 	*x = GetClusterDetailsResponse{}
-	mi := &file_proto_cluster_proto_msgTypes[6]
+	mi := &file_proto_cluster_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -365,7 +574,7 @@ func (x *GetClusterDetailsResponse) String() string {
 func (*GetClusterDetailsResponse) ProtoMessage() {}
 
 func (x *GetClusterDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[6]
+	mi := &file_proto_cluster_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,7 +587,7 @@ func (x *GetClusterDetailsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClusterDetailsResponse.ProtoReflect.Descriptor instead.
 func (*GetClusterDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{6}
+	return file_proto_cluster_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetClusterDetailsResponse) GetConfig() *ClusterConfig {
@@ -403,13 +612,21 @@ type ClusterConfig struct {
 	Bucket        string                 `protobuf:"bytes,4,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
 	ClusterToken  string                 `protobuf:"bytes,6,opt,name=cluster_token,json=clusterToken,proto3" json:"cluster_token,omitempty"`
+	Phase         string                 `protobuf:"bytes,7,opt,name=phase,proto3" json:"phase,omitempty"`
+	Type          string                 `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	Replicas      int32                  `protobuf:"varint,9,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	Size          string                 `protobuf:"bytes,10,opt,name=size,proto3" json:"size,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations   map[string]string      `protobuf:"bytes,12,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// buckets lists every attached bucket; bucket is the one attached for the data purpose
+	Buckets       []*BucketAttachment `protobuf:"bytes,13,rep,name=buckets,proto3" json:"buckets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ClusterConfig) Reset() {
 	*x = ClusterConfig{}
-	mi := &file_proto_cluster_proto_msgTypes[7]
+	mi := &file_proto_cluster_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -421,7 +638,7 @@ func (x *ClusterConfig) String() string {
 func (*ClusterConfig) ProtoMessage() {}
 
 func (x *ClusterConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[7]
+	mi := &file_proto_cluster_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,7 +651,7 @@ func (x *ClusterConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterConfig.ProtoReflect.Descriptor instead.
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{7}
+	return file_proto_cluster_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ClusterConfig) GetName() string {
@@ -479,121 +696,4424 @@ func (x *ClusterConfig) GetClusterToken() string {
 	return ""
 }
 
-type CreateClusterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	CloudProvider string                 `protobuf:"bytes,3,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
-	Region        string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
-	Bucket        string                 `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	Role          string                 `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
-	AuthToken     string                 `protobuf:"bytes,7,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateClusterRequest) Reset() {
-	*x = CreateClusterRequest{}
-	mi := &file_proto_cluster_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateClusterRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateClusterRequest) ProtoMessage() {}
-
-func (x *CreateClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[8]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateClusterRequest.ProtoReflect.Descriptor instead.
-func (*CreateClusterRequest) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *CreateClusterRequest) GetName() string {
+func (x *ClusterConfig) GetPhase() string {
 	if x != nil {
-		return x.Name
+		return x.Phase
 	}
 	return ""
 }
 
-func (x *CreateClusterRequest) GetType() string {
+func (x *ClusterConfig) GetType() string {
 	if x != nil {
 		return x.Type
 	}
 	return ""
 }
 
-func (x *CreateClusterRequest) GetCloudProvider() string {
+func (x *ClusterConfig) GetReplicas() int32 {
 	if x != nil {
-		return x.CloudProvider
+		return x.Replicas
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateClusterRequest) GetRegion() string {
+func (x *ClusterConfig) GetSize() string {
 	if x != nil {
-		return x.Region
+		return x.Size
 	}
 	return ""
 }
 
-func (x *CreateClusterRequest) GetBucket() string {
+func (x *ClusterConfig) GetLabels() map[string]string {
 	if x != nil {
-		return x.Bucket
+		return x.Labels
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateClusterRequest) GetRole() string {
+func (x *ClusterConfig) GetAnnotations() map[string]string {
 	if x != nil {
-		return x.Role
+		return x.Annotations
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateClusterRequest) GetAuthToken() string {
+func (x *ClusterConfig) GetBuckets() []*BucketAttachment {
 	if x != nil {
-		return x.AuthToken
+		return x.Buckets
 	}
-	return ""
+	return nil
 }
 
-type CreateClusterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Config        *ClusterConfig         `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+type BucketAttachment struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bucket string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// purpose is one of data, checkpoints, logs or kb; a cluster has at most one bucket per purpose
+	Purpose string `protobuf:"bytes,2,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// role is the access role used for this bucket
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	AttachedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=attached_at,json=attachedAt,proto3" json:"attached_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateClusterResponse) Reset() {
-	*x = CreateClusterResponse{}
-	mi := &file_proto_cluster_proto_msgTypes[9]
+func (x *BucketAttachment) Reset() {
+	*x = BucketAttachment{}
+	mi := &file_proto_cluster_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateClusterResponse) String() string {
+func (x *BucketAttachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateClusterResponse) ProtoMessage() {}
+func (*BucketAttachment) ProtoMessage() {}
 
-func (x *CreateClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[9]
+func (x *BucketAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BucketAttachment.ProtoReflect.Descriptor instead.
+func (*BucketAttachment) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BucketAttachment) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *BucketAttachment) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *BucketAttachment) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *BucketAttachment) GetAttachedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AttachedAt
+	}
+	return nil
+}
+
+type AttachBucketRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Bucket      string                 `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Purpose     string                 `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	// role defaults to the cluster's role when empty
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// replace detaches the bucket currently attached for the purpose instead of failing
+	Replace       bool   `protobuf:"varint,5,opt,name=replace,proto3" json:"replace,omitempty"`
+	AuthToken     string `protobuf:"bytes,6,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachBucketRequest) Reset() {
+	*x = AttachBucketRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachBucketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachBucketRequest) ProtoMessage() {}
+
+func (x *AttachBucketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachBucketRequest.ProtoReflect.Descriptor instead.
+func (*AttachBucketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AttachBucketRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *AttachBucketRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *AttachBucketRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *AttachBucketRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AttachBucketRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+func (x *AttachBucketRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type AttachBucketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *BucketAttachment      `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachBucketResponse) Reset() {
+	*x = AttachBucketResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachBucketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachBucketResponse) ProtoMessage() {}
+
+func (x *AttachBucketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachBucketResponse.ProtoReflect.Descriptor instead.
+func (*AttachBucketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AttachBucketResponse) GetAttachment() *BucketAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *AttachBucketResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DetachBucketRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// bucket and purpose select the attachments to remove; an empty field matches any value
+	Bucket        string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Purpose       string `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	AuthToken     string `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachBucketRequest) Reset() {
+	*x = DetachBucketRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachBucketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachBucketRequest) ProtoMessage() {}
+
+func (x *DetachBucketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachBucketRequest.ProtoReflect.Descriptor instead.
+func (*DetachBucketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DetachBucketRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *DetachBucketRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *DetachBucketRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *DetachBucketRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type DetachBucketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Detached      []*BucketAttachment    `protobuf:"bytes,1,rep,name=detached,proto3" json:"detached,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachBucketResponse) Reset() {
+	*x = DetachBucketResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachBucketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachBucketResponse) ProtoMessage() {}
+
+func (x *DetachBucketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachBucketResponse.ProtoReflect.Descriptor instead.
+func (*DetachBucketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DetachBucketResponse) GetDetached() []*BucketAttachment {
+	if x != nil {
+		return x.Detached
+	}
+	return nil
+}
+
+func (x *DetachBucketResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListClusterBucketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClusterBucketsRequest) Reset() {
+	*x = ListClusterBucketsRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClusterBucketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClusterBucketsRequest) ProtoMessage() {}
+
+func (x *ListClusterBucketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClusterBucketsRequest.ProtoReflect.Descriptor instead.
+func (*ListClusterBucketsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListClusterBucketsRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ListClusterBucketsRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type ListClusterBucketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*BucketAttachment    `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClusterBucketsResponse) Reset() {
+	*x = ListClusterBucketsResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClusterBucketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClusterBucketsResponse) ProtoMessage() {}
+
+func (x *ListClusterBucketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClusterBucketsResponse.ProtoReflect.Descriptor instead.
+func (*ListClusterBucketsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListClusterBucketsResponse) GetAttachments() []*BucketAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *ListClusterBucketsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Region is a cloud provider region that NStream runs clusters in
+type Region struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the provider's region code, e.g. us-east-1 or europe-west1
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CloudProvider string `protobuf:"bytes,2,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
+	// display_name is the location of the region, e.g. "US East (N. Virginia)"
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// available is false while the region does not accept new clusters or buckets
+	Available     bool          `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	Tiers         []*RegionTier `protobuf:"bytes,5,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Region) Reset() {
+	*x = Region{}
+	mi := &file_proto_cluster_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Region) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Region) ProtoMessage() {}
+
+func (x *Region) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Region.ProtoReflect.Descriptor instead.
+func (*Region) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Region) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Region) GetCloudProvider() string {
+	if x != nil {
+		return x.CloudProvider
+	}
+	return ""
+}
+
+func (x *Region) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Region) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *Region) GetTiers() []*RegionTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+// RegionTier is a cluster tier offered in a region
+type RegionTier struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterType string                 `protobuf:"bytes,1,opt,name=cluster_type,json=clusterType,proto3" json:"cluster_type,omitempty"`
+	// available is false when the tier has no capacity left in the region
+	Available      bool  `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	CreditsPerHour int64 `protobuf:"varint,3,opt,name=credits_per_hour,json=creditsPerHour,proto3" json:"credits_per_hour,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegionTier) Reset() {
+	*x = RegionTier{}
+	mi := &file_proto_cluster_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionTier) ProtoMessage() {}
+
+func (x *RegionTier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionTier.ProtoReflect.Descriptor instead.
+func (*RegionTier) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RegionTier) GetClusterType() string {
+	if x != nil {
+		return x.ClusterType
+	}
+	return ""
+}
+
+func (x *RegionTier) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *RegionTier) GetCreditsPerHour() int64 {
+	if x != nil {
+		return x.CreditsPerHour
+	}
+	return 0
+}
+
+type ListRegionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cloud_provider limits the regions to one provider; empty lists all of them
+	CloudProvider string `protobuf:"bytes,1,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
+	AuthToken     string `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionsRequest) Reset() {
+	*x = ListRegionsRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsRequest) ProtoMessage() {}
+
+func (x *ListRegionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRegionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListRegionsRequest) GetCloudProvider() string {
+	if x != nil {
+		return x.CloudProvider
+	}
+	return ""
+}
+
+func (x *ListRegionsRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type ListRegionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Regions       []*Region              `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionsResponse) Reset() {
+	*x = ListRegionsResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsResponse) ProtoMessage() {}
+
+func (x *ListRegionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRegionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListRegionsResponse) GetRegions() []*Region {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+func (x *ListRegionsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CreateClusterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	CloudProvider string                 `protobuf:"bytes,3,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
+	Region        string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket        string                 `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Role          string                 `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,7,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	// kms_key encrypts a bucket created with the cluster; existing buckets keep their key
+	KmsKey        string `protobuf:"bytes,8,opt,name=kms_key,json=kmsKey,proto3" json:"kms_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClusterRequest) Reset() {
+	*x = CreateClusterRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClusterRequest) ProtoMessage() {}
+
+func (x *CreateClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClusterRequest.ProtoReflect.Descriptor instead.
+func (*CreateClusterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateClusterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateClusterRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateClusterRequest) GetCloudProvider() string {
+	if x != nil {
+		return x.CloudProvider
+	}
+	return ""
+}
+
+func (x *CreateClusterRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CreateClusterRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *CreateClusterRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreateClusterRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+func (x *CreateClusterRequest) GetKmsKey() string {
+	if x != nil {
+		return x.KmsKey
+	}
+	return ""
+}
+
+type CreateClusterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *ClusterConfig         `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClusterResponse) Reset() {
+	*x = CreateClusterResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClusterResponse) ProtoMessage() {}
+
+func (x *CreateClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClusterResponse.ProtoReflect.Descriptor instead.
+func (*CreateClusterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateClusterResponse) GetConfig() *ClusterConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *CreateClusterResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CloneClusterRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SourceClusterName string                 `protobuf:"bytes,1,opt,name=source_cluster_name,json=sourceClusterName,proto3" json:"source_cluster_name,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// type, region, bucket and role override the source cluster's settings when set
+	Type   string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Region string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket string `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Role   string `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	// include_resources copies the source cluster's resource definitions, atomically with the cluster
+	IncludeResources bool   `protobuf:"varint,7,opt,name=include_resources,json=includeResources,proto3" json:"include_resources,omitempty"`
+	AuthToken        string `protobuf:"bytes,8,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CloneClusterRequest) Reset() {
+	*x = CloneClusterRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneClusterRequest) ProtoMessage() {}
+
+func (x *CloneClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneClusterRequest.ProtoReflect.Descriptor instead.
+func (*CloneClusterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CloneClusterRequest) GetSourceClusterName() string {
+	if x != nil {
+		return x.SourceClusterName
+	}
+	return ""
+}
+
+func (x *CloneClusterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneClusterRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CloneClusterRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CloneClusterRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *CloneClusterRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CloneClusterRequest) GetIncludeResources() bool {
+	if x != nil {
+		return x.IncludeResources
+	}
+	return false
+}
+
+func (x *CloneClusterRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type CloneClusterResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Config *ClusterConfig         `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// copied_resources is the number of resource definitions copied from the source cluster
+	CopiedResources int32  `protobuf:"varint,2,opt,name=copied_resources,json=copiedResources,proto3" json:"copied_resources,omitempty"`
+	Error           string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CloneClusterResponse) Reset() {
+	*x = CloneClusterResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneClusterResponse) ProtoMessage() {}
+
+func (x *CloneClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneClusterResponse.ProtoReflect.Descriptor instead.
+func (*CloneClusterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CloneClusterResponse) GetConfig() *ClusterConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *CloneClusterResponse) GetCopiedResources() int32 {
+	if x != nil {
+		return x.CopiedResources
+	}
+	return 0
+}
+
+func (x *CloneClusterResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type WatchClusterRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	AuthToken   string                 `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	// after_sequence resumes a dropped stream after the last status the client received
+	AfterSequence int64 `protobuf:"varint,3,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchClusterRequest) Reset() {
+	*x = WatchClusterRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchClusterRequest) ProtoMessage() {}
+
+func (x *WatchClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchClusterRequest.ProtoReflect.Descriptor instead.
+func (*WatchClusterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WatchClusterRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *WatchClusterRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+func (x *WatchClusterRequest) GetAfterSequence() int64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+// ClusterStatus reports a cluster's provisioning phase
+type ClusterStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName     string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Phase           string                 `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ProgressPercent int32                  `protobuf:"varint,4,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`
+	// done is set once the cluster is ready or provisioning has failed
+	Done          bool                   `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Sequence      int64                  `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterStatus) Reset() {
+	*x = ClusterStatus{}
+	mi := &file_proto_cluster_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterStatus) ProtoMessage() {}
+
+func (x *ClusterStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterStatus.ProtoReflect.Descriptor instead.
+func (*ClusterStatus) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ClusterStatus) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ClusterStatus) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *ClusterStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ClusterStatus) GetProgressPercent() int32 {
+	if x != nil {
+		return x.ProgressPercent
+	}
+	return 0
+}
+
+func (x *ClusterStatus) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ClusterStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ClusterStatus) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ClusterStatus) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type DeleteClusterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClusterRequest) Reset() {
+	*x = DeleteClusterRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClusterRequest) ProtoMessage() {}
+
+func (x *DeleteClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClusterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteClusterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteClusterRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *DeleteClusterRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type DeleteClusterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *ClusterOperation      `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClusterResponse) Reset() {
+	*x = DeleteClusterResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClusterResponse) ProtoMessage() {}
+
+func (x *DeleteClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClusterResponse.ProtoReflect.Descriptor instead.
+func (*DeleteClusterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteClusterResponse) GetOperation() *ClusterOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *DeleteClusterResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type UpdateClusterRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Config      *ClusterConfig         `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	UpdateMask  *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// dry_run validates the update and returns the resulting config without applying it
+	DryRun        bool   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	AuthToken     string `protobuf:"bytes,5,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateClusterRequest) Reset() {
+	*x = UpdateClusterRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClusterRequest) ProtoMessage() {}
+
+func (x *UpdateClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClusterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClusterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateClusterRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *UpdateClusterRequest) GetConfig() *ClusterConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *UpdateClusterRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateClusterRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UpdateClusterRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type UpdateClusterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *ClusterConfig         `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateClusterResponse) Reset() {
+	*x = UpdateClusterResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateClusterResponse) ProtoMessage() {}
+
+func (x *UpdateClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateClusterResponse.ProtoReflect.Descriptor instead.
+func (*UpdateClusterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateClusterResponse) GetConfig() *ClusterConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *UpdateClusterResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ChangeClusterTierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,3,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeClusterTierRequest) Reset() {
+	*x = ChangeClusterTierRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeClusterTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeClusterTierRequest) ProtoMessage() {}
+
+func (x *ChangeClusterTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeClusterTierRequest.ProtoReflect.Descriptor instead.
+func (*ChangeClusterTierRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ChangeClusterTierRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ChangeClusterTierRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ChangeClusterTierRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type ChangeClusterTierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *ClusterOperation      `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeClusterTierResponse) Reset() {
+	*x = ChangeClusterTierResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeClusterTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeClusterTierResponse) ProtoMessage() {}
+
+func (x *ChangeClusterTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeClusterTierResponse.ProtoReflect.Descriptor instead.
+func (*ChangeClusterTierResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ChangeClusterTierResponse) GetOperation() *ClusterOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *ChangeClusterTierResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PauseClusterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseClusterRequest) Reset() {
+	*x = PauseClusterRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseClusterRequest) ProtoMessage() {}
+
+func (x *PauseClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseClusterRequest.ProtoReflect.Descriptor instead.
+func (*PauseClusterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PauseClusterRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *PauseClusterRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type PauseClusterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *ClusterOperation      `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseClusterResponse) Reset() {
+	*x = PauseClusterResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseClusterResponse) ProtoMessage() {}
+
+func (x *PauseClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseClusterResponse.ProtoReflect.Descriptor instead.
+func (*PauseClusterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PauseClusterResponse) GetOperation() *ClusterOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *PauseClusterResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ResumeClusterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeClusterRequest) Reset() {
+	*x = ResumeClusterRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeClusterRequest) ProtoMessage() {}
+
+func (x *ResumeClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeClusterRequest.ProtoReflect.Descriptor instead.
+func (*ResumeClusterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ResumeClusterRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ResumeClusterRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type ResumeClusterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *ClusterOperation      `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeClusterResponse) Reset() {
+	*x = ResumeClusterResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeClusterResponse) ProtoMessage() {}
+
+func (x *ResumeClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeClusterResponse.ProtoReflect.Descriptor instead.
+func (*ResumeClusterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ResumeClusterResponse) GetOperation() *ClusterOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *ResumeClusterResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ScaleClusterRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// replicas is left unchanged when 0
+	Replicas int32 `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// size is left unchanged when empty
+	Size          string `protobuf:"bytes,3,opt,name=size,proto3" json:"size,omitempty"`
+	AuthToken     string `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleClusterRequest) Reset() {
+	*x = ScaleClusterRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleClusterRequest) ProtoMessage() {}
+
+func (x *ScaleClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleClusterRequest.ProtoReflect.Descriptor instead.
+func (*ScaleClusterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ScaleClusterRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ScaleClusterRequest) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *ScaleClusterRequest) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *ScaleClusterRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type ScaleClusterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *ClusterOperation      `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleClusterResponse) Reset() {
+	*x = ScaleClusterResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleClusterResponse) ProtoMessage() {}
+
+func (x *ScaleClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleClusterResponse.ProtoReflect.Descriptor instead.
+func (*ScaleClusterResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ScaleClusterResponse) GetOperation() *ClusterOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *ScaleClusterResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cluster_name is optional; events of all clusters are returned when empty
+	ClusterName string `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// kind is optional and filters on the kind of resource involved
+	Kind      string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Since     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	AuthToken string                 `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	// page_size caps the number of events returned; the server picks a default when 0
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a previous listing from its next_page_token
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// filter is a server-side expression on Event fields, such as "severity=error AND kind=model"
+	Filter string `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	// order_by is a comma-separated list of fields, each optionally followed by " desc"
+	OrderBy       string `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListEventsRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListEventsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Error  string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// next_page_token is empty on the last page
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListEventsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ListEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type WatchEventsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Kind        string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// after_sequence streams events recorded after the given sequence; 0 streams only new events
+	AfterSequence int64  `protobuf:"varint,3,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+	AuthToken     string `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	// filter is the same expression on Event fields accepted by ListEventsRequest
+	Filter        string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest) ProtoMessage() {}
+
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *WatchEventsRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetAfterSequence() int64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+func (x *WatchEventsRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+// Event records something that happened to a cluster or one of its resources
+type Event struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClusterName string                 `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// severity is one of "info", "warning" or "error"
+	Severity string `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	// kind is the kind of resource involved, e.g. "cluster", "bucket", "model" or "knowledgebase"
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Resource      string                 `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Sequence      int64                  `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_proto_cluster_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *Event) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Event) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Event) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *Event) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Event) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Event) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Event) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type ExportResourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName   string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportResourcesRequest) Reset() {
+	*x = ExportResourcesRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResourcesRequest) ProtoMessage() {}
+
+func (x *ExportResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ExportResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ExportResourcesRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ExportResourcesRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type ExportResourcesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resources     []*ResourceSpec        `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportResourcesResponse) Reset() {
+	*x = ExportResourcesResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResourcesResponse) ProtoMessage() {}
+
+func (x *ExportResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ExportResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExportResourcesResponse) GetResources() []*ResourceSpec {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ExportResourcesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ApplyResourceRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterName string                 `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Resource    *ResourceSpec          `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	// overwrite replaces an existing resource of the same kind and name instead of failing
+	Overwrite     bool   `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	AuthToken     string `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyResourceRequest) Reset() {
+	*x = ApplyResourceRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyResourceRequest) ProtoMessage() {}
+
+func (x *ApplyResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyResourceRequest.ProtoReflect.Descriptor instead.
+func (*ApplyResourceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ApplyResourceRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ApplyResourceRequest) GetResource() *ResourceSpec {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *ApplyResourceRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+func (x *ApplyResourceRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type ApplyResourceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// result is "created" or "updated"
+	Result        string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyResourceResponse) Reset() {
+	*x = ApplyResourceResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyResourceResponse) ProtoMessage() {}
+
+func (x *ApplyResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyResourceResponse.ProtoReflect.Descriptor instead.
+func (*ApplyResourceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ApplyResourceResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ApplyResourceResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ResourceSpec is the portable definition of a resource running on a cluster
+type ResourceSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind is one of "connector", "model", "knowledgebase", "finetuner" or "graph"
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// depends_on lists the resources, as "kind/name", that must exist before this one
+	DependsOn []string `protobuf:"bytes,3,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	// spec is the resource definition as JSON; secret values are never included
+	Spec          string `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceSpec) Reset() {
+	*x = ResourceSpec{}
+	mi := &file_proto_cluster_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceSpec) ProtoMessage() {}
+
+func (x *ResourceSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceSpec.ProtoReflect.Descriptor instead.
+func (*ResourceSpec) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ResourceSpec) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ResourceSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceSpec) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *ResourceSpec) GetSpec() string {
+	if x != nil {
+		return x.Spec
+	}
+	return ""
+}
+
+type GetClusterOperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterOperationRequest) Reset() {
+	*x = GetClusterOperationRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterOperationRequest) ProtoMessage() {}
+
+func (x *GetClusterOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterOperationRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterOperationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetClusterOperationRequest) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *GetClusterOperationRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type GetClusterOperationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     *ClusterOperation      `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterOperationResponse) Reset() {
+	*x = GetClusterOperationResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterOperationResponse) ProtoMessage() {}
+
+func (x *GetClusterOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterOperationResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterOperationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetClusterOperationResponse) GetOperation() *ClusterOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *GetClusterOperationResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ClusterOperation tracks a long-running change to a cluster
+type ClusterOperation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClusterName     string                 `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	Type            string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Phase           string                 `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	ProgressPercent int32                  `protobuf:"varint,5,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`
+	Message         string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Done            bool                   `protobuf:"varint,7,opt,name=done,proto3" json:"done,omitempty"`
+	Error           string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ClusterOperation) Reset() {
+	*x = ClusterOperation{}
+	mi := &file_proto_cluster_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterOperation) ProtoMessage() {}
+
+func (x *ClusterOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterOperation.ProtoReflect.Descriptor instead.
+func (*ClusterOperation) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ClusterOperation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClusterOperation) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ClusterOperation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ClusterOperation) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *ClusterOperation) GetProgressPercent() int32 {
+	if x != nil {
+		return x.ProgressPercent
+	}
+	return 0
+}
+
+func (x *ClusterOperation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ClusterOperation) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ClusterOperation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ClusterOperation) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ClusterOperation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Bucket messages
+type ListBucketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CloudProvider string                 `protobuf:"bytes,1,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	// selector only returns buckets whose labels match
+	Selector *LabelSelector `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
+	// page_size caps the number of buckets returned; the server picks a default when 0
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token continues a previous listing from its next_page_token
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// filter is a server-side expression on Bucket fields, such as "provider=aws AND region=us-east-1"
+	Filter string `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+	// order_by is a comma-separated list of fields, each optionally followed by " desc"
+	OrderBy       string `protobuf:"bytes,7,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBucketsRequest) Reset() {
+	*x = ListBucketsRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBucketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBucketsRequest) ProtoMessage() {}
+
+func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBucketsRequest.ProtoReflect.Descriptor instead.
+func (*ListBucketsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListBucketsRequest) GetCloudProvider() string {
+	if x != nil {
+		return x.CloudProvider
+	}
+	return ""
+}
+
+func (x *ListBucketsRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+func (x *ListBucketsRequest) GetSelector() *LabelSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *ListBucketsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBucketsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListBucketsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListBucketsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListBucketsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Buckets []*Bucket              `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// next_page_token is empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBucketsResponse) Reset() {
+	*x = ListBucketsResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBucketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBucketsResponse) ProtoMessage() {}
+
+func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBucketsResponse.ProtoReflect.Descriptor instead.
+func (*ListBucketsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListBucketsResponse) GetBuckets() []*Bucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *ListBucketsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Bucket struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Region      string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Provider    string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	Size        string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Labels      map[string]string      `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations map[string]string      `protobuf:"bytes,7,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// storage_class is provider specific, e.g. STANDARD (aws, gcp) or Hot (azure)
+	StorageClass string `protobuf:"bytes,8,opt,name=storage_class,json=storageClass,proto3" json:"storage_class,omitempty"`
+	Versioning   bool   `protobuf:"varint,9,opt,name=versioning,proto3" json:"versioning,omitempty"`
+	// s3_compatible is set when provider is s3compatible
+	S3Compatible *S3CompatibleConfig `protobuf:"bytes,10,opt,name=s3_compatible,json=s3Compatible,proto3" json:"s3_compatible,omitempty"`
+	// kms_key is the customer-managed key objects are encrypted with: a KMS key ARN (aws), a
+	// Cloud KMS key name (gcp), a Key Vault key URL (azure) or a server-side key ID
+	// (s3compatible). Empty when the provider's default encryption is used.
+	KmsKey        string `protobuf:"bytes,11,opt,name=kms_key,json=kmsKey,proto3" json:"kms_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bucket) Reset() {
+	*x = Bucket{}
+	mi := &file_proto_cluster_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bucket) ProtoMessage() {}
+
+func (x *Bucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bucket.ProtoReflect.Descriptor instead.
+func (*Bucket) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Bucket) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Bucket) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Bucket) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Bucket) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *Bucket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Bucket) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Bucket) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Bucket) GetStorageClass() string {
+	if x != nil {
+		return x.StorageClass
+	}
+	return ""
+}
+
+func (x *Bucket) GetVersioning() bool {
+	if x != nil {
+		return x.Versioning
+	}
+	return false
+}
+
+func (x *Bucket) GetS3Compatible() *S3CompatibleConfig {
+	if x != nil {
+		return x.S3Compatible
+	}
+	return nil
+}
+
+func (x *Bucket) GetKmsKey() string {
+	if x != nil {
+		return x.KmsKey
+	}
+	return ""
+}
+
+// S3CompatibleConfig locates a bucket on a self-hosted or third-party S3-compatible server,
+// such as MinIO, Cloudflare R2 or Ceph
+type S3CompatibleConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// endpoint is the base URL of the S3 API, e.g. https://minio.example.com:9000
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// path_style addresses buckets as <endpoint>/<bucket> instead of <bucket>.<endpoint host>
+	PathStyle bool `protobuf:"varint,2,opt,name=path_style,json=pathStyle,proto3" json:"path_style,omitempty"`
+	// credentials is "static" to use the keys in credentials_secret directly, or "sts" to
+	// assume role_arn with them
+	Credentials string `protobuf:"bytes,3,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	// credentials_secret names the platform secret holding access_key_id and secret_access_key;
+	// the key values are never sent to or returned by the CLI
+	CredentialsSecret string `protobuf:"bytes,4,opt,name=credentials_secret,json=credentialsSecret,proto3" json:"credentials_secret,omitempty"`
+	RoleArn           string `protobuf:"bytes,5,opt,name=role_arn,json=roleArn,proto3" json:"role_arn,omitempty"`
+	// sts_endpoint is the URL of the STS API; defaults to endpoint
+	StsEndpoint   string `protobuf:"bytes,6,opt,name=sts_endpoint,json=stsEndpoint,proto3" json:"sts_endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *S3CompatibleConfig) Reset() {
+	*x = S3CompatibleConfig{}
+	mi := &file_proto_cluster_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *S3CompatibleConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S3CompatibleConfig) ProtoMessage() {}
+
+func (x *S3CompatibleConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S3CompatibleConfig.ProtoReflect.Descriptor instead.
+func (*S3CompatibleConfig) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *S3CompatibleConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *S3CompatibleConfig) GetPathStyle() bool {
+	if x != nil {
+		return x.PathStyle
+	}
+	return false
+}
+
+func (x *S3CompatibleConfig) GetCredentials() string {
+	if x != nil {
+		return x.Credentials
+	}
+	return ""
+}
+
+func (x *S3CompatibleConfig) GetCredentialsSecret() string {
+	if x != nil {
+		return x.CredentialsSecret
+	}
+	return ""
+}
+
+func (x *S3CompatibleConfig) GetRoleArn() string {
+	if x != nil {
+		return x.RoleArn
+	}
+	return ""
+}
+
+func (x *S3CompatibleConfig) GetStsEndpoint() string {
+	if x != nil {
+		return x.StsEndpoint
+	}
+	return ""
+}
+
+type VerifyBucketAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CloudProvider string                 `protobuf:"bytes,1,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
+	Bucket        string                 `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyBucketAccessRequest) Reset() {
+	*x = VerifyBucketAccessRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyBucketAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBucketAccessRequest) ProtoMessage() {}
+
+func (x *VerifyBucketAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBucketAccessRequest.ProtoReflect.Descriptor instead.
+func (*VerifyBucketAccessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *VerifyBucketAccessRequest) GetCloudProvider() string {
+	if x != nil {
+		return x.CloudProvider
+	}
+	return ""
+}
+
+func (x *VerifyBucketAccessRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *VerifyBucketAccessRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *VerifyBucketAccessRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type VerifyBucketAccessResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	HasAccess bool                   `protobuf:"varint,1,opt,name=has_access,json=hasAccess,proto3" json:"has_access,omitempty"`
+	Error     string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// checks holds the result of each individual permission check, in the order they ran
+	Checks        []*AccessCheck `protobuf:"bytes,3,rep,name=checks,proto3" json:"checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyBucketAccessResponse) Reset() {
+	*x = VerifyBucketAccessResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyBucketAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBucketAccessResponse) ProtoMessage() {}
+
+func (x *VerifyBucketAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBucketAccessResponse.ProtoReflect.Descriptor instead.
+func (*VerifyBucketAccessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *VerifyBucketAccessResponse) GetHasAccess() bool {
+	if x != nil {
+		return x.HasAccess
+	}
+	return false
+}
+
+func (x *VerifyBucketAccessResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *VerifyBucketAccessResponse) GetChecks() []*AccessCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type AccessCheck struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is one of assume-role, list, get, put, delete or kms-decrypt
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// status is passed, failed or skipped; checks are skipped when an earlier one failed or
+	// does not apply, like kms-decrypt on a bucket without a customer-managed key
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// error_code is the cloud provider's error code of a failed check, e.g. AccessDenied
+	ErrorCode     string `protobuf:"bytes,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessCheck) Reset() {
+	*x = AccessCheck{}
+	mi := &file_proto_cluster_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessCheck) ProtoMessage() {}
+
+func (x *AccessCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessCheck.ProtoReflect.Descriptor instead.
+func (*AccessCheck) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AccessCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccessCheck) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AccessCheck) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *AccessCheck) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetServiceRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CloudProvider string                 `protobuf:"bytes,1,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceRoleRequest) Reset() {
+	*x = GetServiceRoleRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceRoleRequest) ProtoMessage() {}
+
+func (x *GetServiceRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceRoleRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetServiceRoleRequest) GetCloudProvider() string {
+	if x != nil {
+		return x.CloudProvider
+	}
+	return ""
+}
+
+func (x *GetServiceRoleRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type GetServiceRoleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// service_role is the NStream principal: an IAM role ARN on aws, a service account email on gcp
+	// and the federated workload identity subject on azure
+	ServiceRole string `protobuf:"bytes,1,opt,name=service_role,json=serviceRole,proto3" json:"service_role,omitempty"`
+	// external_id must be required in the trust policy of the bucket access role on aws
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// issuer is the OIDC issuer of the federated workload identity on azure
+	Issuer        string `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceRoleResponse) Reset() {
+	*x = GetServiceRoleResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceRoleResponse) ProtoMessage() {}
+
+func (x *GetServiceRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceRoleResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceRoleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetServiceRoleResponse) GetServiceRole() string {
+	if x != nil {
+		return x.ServiceRole
+	}
+	return ""
+}
+
+func (x *GetServiceRoleResponse) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *GetServiceRoleResponse) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *GetServiceRoleResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CheckResourceReadinessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CloudProvider string                 `protobuf:"bytes,1,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
+	Bucket        string                 `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	// kms_key is checked instead of the bucket's own key when set, e.g. for a bucket that is
+	// created with the cluster
+	KmsKey        string `protobuf:"bytes,5,opt,name=kms_key,json=kmsKey,proto3" json:"kms_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckResourceReadinessRequest) Reset() {
+	*x = CheckResourceReadinessRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckResourceReadinessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResourceReadinessRequest) ProtoMessage() {}
+
+func (x *CheckResourceReadinessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckResourceReadinessRequest.ProtoReflect.Descriptor instead.
+func (*CheckResourceReadinessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CheckResourceReadinessRequest) GetCloudProvider() string {
+	if x != nil {
+		return x.CloudProvider
+	}
+	return ""
+}
+
+func (x *CheckResourceReadinessRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *CheckResourceReadinessRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CheckResourceReadinessRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+func (x *CheckResourceReadinessRequest) GetKmsKey() string {
+	if x != nil {
+		return x.KmsKey
+	}
+	return ""
+}
+
+type CheckResourceReadinessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ready         bool                   `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Checks        []*AccessCheck         `protobuf:"bytes,3,rep,name=checks,proto3" json:"checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckResourceReadinessResponse) Reset() {
+	*x = CheckResourceReadinessResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckResourceReadinessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResourceReadinessResponse) ProtoMessage() {}
+
+func (x *CheckResourceReadinessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckResourceReadinessResponse.ProtoReflect.Descriptor instead.
+func (*CheckResourceReadinessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CheckResourceReadinessResponse) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *CheckResourceReadinessResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CheckResourceReadinessResponse) GetChecks() []*AccessCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type CreateBucketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CloudProvider string                 `protobuf:"bytes,2,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
+	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	StorageClass  string                 `protobuf:"bytes,4,opt,name=storage_class,json=storageClass,proto3" json:"storage_class,omitempty"`
+	Versioning    bool                   `protobuf:"varint,5,opt,name=versioning,proto3" json:"versioning,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AuthToken     string                 `protobuf:"bytes,7,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	// s3_compatible is required when cloud_provider is s3compatible
+	S3Compatible  *S3CompatibleConfig `protobuf:"bytes,8,opt,name=s3_compatible,json=s3Compatible,proto3" json:"s3_compatible,omitempty"`
+	KmsKey        string              `protobuf:"bytes,9,opt,name=kms_key,json=kmsKey,proto3" json:"kms_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBucketRequest) Reset() {
+	*x = CreateBucketRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBucketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBucketRequest) ProtoMessage() {}
+
+func (x *CreateBucketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBucketRequest.ProtoReflect.Descriptor instead.
+func (*CreateBucketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateBucketRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBucketRequest) GetCloudProvider() string {
+	if x != nil {
+		return x.CloudProvider
+	}
+	return ""
+}
+
+func (x *CreateBucketRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CreateBucketRequest) GetStorageClass() string {
+	if x != nil {
+		return x.StorageClass
+	}
+	return ""
+}
+
+func (x *CreateBucketRequest) GetVersioning() bool {
+	if x != nil {
+		return x.Versioning
+	}
+	return false
+}
+
+func (x *CreateBucketRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateBucketRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+func (x *CreateBucketRequest) GetS3Compatible() *S3CompatibleConfig {
+	if x != nil {
+		return x.S3Compatible
+	}
+	return nil
+}
+
+func (x *CreateBucketRequest) GetKmsKey() string {
+	if x != nil {
+		return x.KmsKey
+	}
+	return ""
+}
+
+type CreateBucketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        *Bucket                `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBucketResponse) Reset() {
+	*x = CreateBucketResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBucketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBucketResponse) ProtoMessage() {}
+
+func (x *CreateBucketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBucketResponse.ProtoReflect.Descriptor instead.
+func (*CreateBucketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateBucketResponse) GetBucket() *Bucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+func (x *CreateBucketResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetBucketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketName    string                 `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBucketRequest) Reset() {
+	*x = GetBucketRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBucketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBucketRequest) ProtoMessage() {}
+
+func (x *GetBucketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBucketRequest.ProtoReflect.Descriptor instead.
+func (*GetBucketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetBucketRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *GetBucketRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type GetBucketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        *Bucket                `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBucketResponse) Reset() {
+	*x = GetBucketResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBucketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBucketResponse) ProtoMessage() {}
+
+func (x *GetBucketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBucketResponse.ProtoReflect.Descriptor instead.
+func (*GetBucketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetBucketResponse) GetBucket() *Bucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+func (x *GetBucketResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DeleteBucketRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	BucketName string                 `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// delete_objects empties the bucket first; otherwise a bucket with objects is not deleted
+	DeleteObjects bool   `protobuf:"varint,2,opt,name=delete_objects,json=deleteObjects,proto3" json:"delete_objects,omitempty"`
+	AuthToken     string `protobuf:"bytes,3,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBucketRequest) Reset() {
+	*x = DeleteBucketRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBucketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBucketRequest) ProtoMessage() {}
+
+func (x *DeleteBucketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBucketRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBucketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteBucketRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *DeleteBucketRequest) GetDeleteObjects() bool {
+	if x != nil {
+		return x.DeleteObjects
+	}
+	return false
+}
+
+func (x *DeleteBucketRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type DeleteBucketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBucketResponse) Reset() {
+	*x = DeleteBucketResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBucketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBucketResponse) ProtoMessage() {}
+
+func (x *DeleteBucketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBucketResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBucketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteBucketResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type UpdateBucketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketName    string                 `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	Bucket        *Bucket                `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBucketRequest) Reset() {
+	*x = UpdateBucketRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBucketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBucketRequest) ProtoMessage() {}
+
+func (x *UpdateBucketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBucketRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBucketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UpdateBucketRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *UpdateBucketRequest) GetBucket() *Bucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+func (x *UpdateBucketRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateBucketRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type UpdateBucketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        *Bucket                `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBucketResponse) Reset() {
+	*x = UpdateBucketResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBucketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBucketResponse) ProtoMessage() {}
+
+func (x *UpdateBucketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBucketResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBucketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpdateBucketResponse) GetBucket() *Bucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+func (x *UpdateBucketResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SignObjectURLRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bucket string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key    string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// method is the HTTP method the URL is valid for: "GET", "HEAD", "PUT" or "DELETE"
+	Method    string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	AuthToken string `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	// upload_id and part_number sign a PUT for one part of a multipart upload
+	UploadId      string `protobuf:"bytes,5,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	PartNumber    int32  `protobuf:"varint,6,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignObjectURLRequest) Reset() {
+	*x = SignObjectURLRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignObjectURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignObjectURLRequest) ProtoMessage() {}
+
+func (x *SignObjectURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignObjectURLRequest.ProtoReflect.Descriptor instead.
+func (*SignObjectURLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SignObjectURLRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *SignObjectURLRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SignObjectURLRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SignObjectURLRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+func (x *SignObjectURLRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *SignObjectURLRequest) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+type SignObjectURLResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// headers must be sent with the request for the signature to be valid
+	Headers       map[string]string      `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignObjectURLResponse) Reset() {
+	*x = SignObjectURLResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignObjectURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignObjectURLResponse) ProtoMessage() {}
+
+func (x *SignObjectURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignObjectURLResponse.ProtoReflect.Descriptor instead.
+func (*SignObjectURLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SignObjectURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SignObjectURLResponse) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *SignObjectURLResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *SignObjectURLResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListObjectsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bucket string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Prefix string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// delimiter groups keys sharing a prefix up to the delimiter into common_prefixes; empty lists recursively
+	Delimiter     string `protobuf:"bytes,3,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	AuthToken     string `protobuf:"bytes,6,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListObjectsRequest) Reset() {
+	*x = ListObjectsRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectsRequest) ProtoMessage() {}
+
+func (x *ListObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListObjectsRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListObjectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListObjectsRequest) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
+type ListObjectsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Objects        []*Object              `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	CommonPrefixes []string               `protobuf:"bytes,2,rep,name=common_prefixes,json=commonPrefixes,proto3" json:"common_prefixes,omitempty"`
+	NextPageToken  string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Error          string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListObjectsResponse) Reset() {
+	*x = ListObjectsResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectsResponse) ProtoMessage() {}
+
+func (x *ListObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListObjectsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListObjectsResponse) GetObjects() []*Object {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *ListObjectsResponse) GetCommonPrefixes() []string {
+	if x != nil {
+		return x.CommonPrefixes
+	}
+	return nil
+}
+
+func (x *ListObjectsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListObjectsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Object struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	LastModified  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
+	Etag          string                 `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	StorageClass  string                 `protobuf:"bytes,5,opt,name=storage_class,json=storageClass,proto3" json:"storage_class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object) Reset() {
+	*x = Object{}
+	mi := &file_proto_cluster_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object) ProtoMessage() {}
+
+func (x *Object) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,49 +5124,71 @@ func (x *CreateClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateClusterResponse.ProtoReflect.Descriptor instead.
-func (*CreateClusterResponse) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Object.ProtoReflect.Descriptor instead.
+func (*Object) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *CreateClusterResponse) GetConfig() *ClusterConfig {
+func (x *Object) GetKey() string {
 	if x != nil {
-		return x.Config
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Object) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Object) GetLastModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModified
 	}
 	return nil
 }
 
-func (x *CreateClusterResponse) GetError() string {
+func (x *Object) GetEtag() string {
 	if x != nil {
-		return x.Error
+		return x.Etag
 	}
 	return ""
 }
 
-// Bucket messages
-type ListBucketsRequest struct {
+func (x *Object) GetStorageClass() string {
+	if x != nil {
+		return x.StorageClass
+	}
+	return ""
+}
+
+type StartMultipartUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CloudProvider string                 `protobuf:"bytes,1,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
-	AuthToken     string                 `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBucketsRequest) Reset() {
-	*x = ListBucketsRequest{}
-	mi := &file_proto_cluster_proto_msgTypes[10]
+func (x *StartMultipartUploadRequest) Reset() {
+	*x = StartMultipartUploadRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBucketsRequest) String() string {
+func (x *StartMultipartUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBucketsRequest) ProtoMessage() {}
+func (*StartMultipartUploadRequest) ProtoMessage() {}
 
-func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[10]
+func (x *StartMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -657,47 +5199,62 @@ func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBucketsRequest.ProtoReflect.Descriptor instead.
-func (*ListBucketsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use StartMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*StartMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ListBucketsRequest) GetCloudProvider() string {
+func (x *StartMultipartUploadRequest) GetBucket() string {
 	if x != nil {
-		return x.CloudProvider
+		return x.Bucket
 	}
 	return ""
 }
 
-func (x *ListBucketsRequest) GetAuthToken() string {
+func (x *StartMultipartUploadRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StartMultipartUploadRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *StartMultipartUploadRequest) GetAuthToken() string {
 	if x != nil {
 		return x.AuthToken
 	}
 	return ""
 }
 
-type ListBucketsResponse struct {
+type StartMultipartUploadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Buckets       []*Bucket              `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBucketsResponse) Reset() {
-	*x = ListBucketsResponse{}
-	mi := &file_proto_cluster_proto_msgTypes[11]
+func (x *StartMultipartUploadResponse) Reset() {
+	*x = StartMultipartUploadResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBucketsResponse) String() string {
+func (x *StartMultipartUploadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBucketsResponse) ProtoMessage() {}
+func (*StartMultipartUploadResponse) ProtoMessage() {}
 
-func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[11]
+func (x *StartMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,44 +5265,49 @@ func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBucketsResponse.ProtoReflect.Descriptor instead.
-func (*ListBucketsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use StartMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*StartMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ListBucketsResponse) GetBuckets() []*Bucket {
+func (x *StartMultipartUploadResponse) GetUploadId() string {
 	if x != nil {
-		return x.Buckets
+		return x.UploadId
 	}
-	return nil
+	return ""
 }
 
-type Bucket struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
-	Provider      string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
-	Size          string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+func (x *StartMultipartUploadResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CompletedPart struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PartNumber int32                  `protobuf:"varint,1,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	// etag is the ETag header returned when the part was uploaded
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Bucket) Reset() {
-	*x = Bucket{}
-	mi := &file_proto_cluster_proto_msgTypes[12]
+func (x *CompletedPart) Reset() {
+	*x = CompletedPart{}
+	mi := &file_proto_cluster_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Bucket) String() string {
+func (x *CompletedPart) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Bucket) ProtoMessage() {}
+func (*CompletedPart) ProtoMessage() {}
 
-func (x *Bucket) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[12]
+func (x *CompletedPart) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,71 +5318,51 @@ func (x *Bucket) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Bucket.ProtoReflect.Descriptor instead.
-func (*Bucket) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *Bucket) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Bucket) GetRegion() string {
-	if x != nil {
-		return x.Region
-	}
-	return ""
+// Deprecated: Use CompletedPart.ProtoReflect.Descriptor instead.
+func (*CompletedPart) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *Bucket) GetProvider() string {
+func (x *CompletedPart) GetPartNumber() int32 {
 	if x != nil {
-		return x.Provider
+		return x.PartNumber
 	}
-	return ""
+	return 0
 }
 
-func (x *Bucket) GetSize() string {
+func (x *CompletedPart) GetEtag() string {
 	if x != nil {
-		return x.Size
+		return x.Etag
 	}
 	return ""
 }
 
-func (x *Bucket) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-type VerifyBucketAccessRequest struct {
+type CompleteMultipartUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CloudProvider string                 `protobuf:"bytes,1,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
-	Bucket        string                 `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	AuthToken     string                 `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	UploadId      string                 `protobuf:"bytes,3,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Parts         []*CompletedPart       `protobuf:"bytes,4,rep,name=parts,proto3" json:"parts,omitempty"`
+	AuthToken     string                 `protobuf:"bytes,5,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyBucketAccessRequest) Reset() {
-	*x = VerifyBucketAccessRequest{}
-	mi := &file_proto_cluster_proto_msgTypes[13]
+func (x *CompleteMultipartUploadRequest) Reset() {
+	*x = CompleteMultipartUploadRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyBucketAccessRequest) String() string {
+func (x *CompleteMultipartUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyBucketAccessRequest) ProtoMessage() {}
+func (*CompleteMultipartUploadRequest) ProtoMessage() {}
 
-func (x *VerifyBucketAccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[13]
+func (x *CompleteMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,62 +5373,69 @@ func (x *VerifyBucketAccessRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyBucketAccessRequest.ProtoReflect.Descriptor instead.
-func (*VerifyBucketAccessRequest) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CompleteMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*CompleteMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *VerifyBucketAccessRequest) GetCloudProvider() string {
+func (x *CompleteMultipartUploadRequest) GetBucket() string {
 	if x != nil {
-		return x.CloudProvider
+		return x.Bucket
 	}
 	return ""
 }
 
-func (x *VerifyBucketAccessRequest) GetBucket() string {
+func (x *CompleteMultipartUploadRequest) GetKey() string {
 	if x != nil {
-		return x.Bucket
+		return x.Key
 	}
 	return ""
 }
 
-func (x *VerifyBucketAccessRequest) GetRole() string {
+func (x *CompleteMultipartUploadRequest) GetUploadId() string {
 	if x != nil {
-		return x.Role
+		return x.UploadId
 	}
 	return ""
 }
 
-func (x *VerifyBucketAccessRequest) GetAuthToken() string {
+func (x *CompleteMultipartUploadRequest) GetParts() []*CompletedPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *CompleteMultipartUploadRequest) GetAuthToken() string {
 	if x != nil {
 		return x.AuthToken
 	}
 	return ""
 }
 
-type VerifyBucketAccessResponse struct {
+type CompleteMultipartUploadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HasAccess     bool                   `protobuf:"varint,1,opt,name=has_access,json=hasAccess,proto3" json:"has_access,omitempty"`
+	Object        *Object                `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
 	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyBucketAccessResponse) Reset() {
-	*x = VerifyBucketAccessResponse{}
-	mi := &file_proto_cluster_proto_msgTypes[14]
+func (x *CompleteMultipartUploadResponse) Reset() {
+	*x = CompleteMultipartUploadResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyBucketAccessResponse) String() string {
+func (x *CompleteMultipartUploadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyBucketAccessResponse) ProtoMessage() {}
+func (*CompleteMultipartUploadResponse) ProtoMessage() {}
 
-func (x *VerifyBucketAccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[14]
+func (x *CompleteMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,50 +5446,50 @@ func (x *VerifyBucketAccessResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyBucketAccessResponse.ProtoReflect.Descriptor instead.
-func (*VerifyBucketAccessResponse) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CompleteMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*CompleteMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *VerifyBucketAccessResponse) GetHasAccess() bool {
+func (x *CompleteMultipartUploadResponse) GetObject() *Object {
 	if x != nil {
-		return x.HasAccess
+		return x.Object
 	}
-	return false
+	return nil
 }
 
-func (x *VerifyBucketAccessResponse) GetError() string {
+func (x *CompleteMultipartUploadResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type CheckResourceReadinessRequest struct {
+type AbortMultipartUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CloudProvider string                 `protobuf:"bytes,1,opt,name=cloud_provider,json=cloudProvider,proto3" json:"cloud_provider,omitempty"`
-	Bucket        string                 `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	UploadId      string                 `protobuf:"bytes,3,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
 	AuthToken     string                 `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckResourceReadinessRequest) Reset() {
-	*x = CheckResourceReadinessRequest{}
-	mi := &file_proto_cluster_proto_msgTypes[15]
+func (x *AbortMultipartUploadRequest) Reset() {
+	*x = AbortMultipartUploadRequest{}
+	mi := &file_proto_cluster_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckResourceReadinessRequest) String() string {
+func (x *AbortMultipartUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckResourceReadinessRequest) ProtoMessage() {}
+func (*AbortMultipartUploadRequest) ProtoMessage() {}
 
-func (x *CheckResourceReadinessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[15]
+func (x *AbortMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -951,62 +5500,61 @@ func (x *CheckResourceReadinessRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckResourceReadinessRequest.ProtoReflect.Descriptor instead.
-func (*CheckResourceReadinessRequest) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AbortMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*AbortMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *CheckResourceReadinessRequest) GetCloudProvider() string {
+func (x *AbortMultipartUploadRequest) GetBucket() string {
 	if x != nil {
-		return x.CloudProvider
+		return x.Bucket
 	}
 	return ""
 }
 
-func (x *CheckResourceReadinessRequest) GetBucket() string {
+func (x *AbortMultipartUploadRequest) GetKey() string {
 	if x != nil {
-		return x.Bucket
+		return x.Key
 	}
 	return ""
 }
 
-func (x *CheckResourceReadinessRequest) GetRole() string {
+func (x *AbortMultipartUploadRequest) GetUploadId() string {
 	if x != nil {
-		return x.Role
+		return x.UploadId
 	}
 	return ""
 }
 
-func (x *CheckResourceReadinessRequest) GetAuthToken() string {
+func (x *AbortMultipartUploadRequest) GetAuthToken() string {
 	if x != nil {
 		return x.AuthToken
 	}
 	return ""
 }
 
-type CheckResourceReadinessResponse struct {
+type AbortMultipartUploadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ready         bool                   `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckResourceReadinessResponse) Reset() {
-	*x = CheckResourceReadinessResponse{}
-	mi := &file_proto_cluster_proto_msgTypes[16]
+func (x *AbortMultipartUploadResponse) Reset() {
+	*x = AbortMultipartUploadResponse{}
+	mi := &file_proto_cluster_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckResourceReadinessResponse) String() string {
+func (x *AbortMultipartUploadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckResourceReadinessResponse) ProtoMessage() {}
+func (*AbortMultipartUploadResponse) ProtoMessage() {}
 
-func (x *CheckResourceReadinessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_cluster_proto_msgTypes[16]
+func (x *AbortMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cluster_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,19 +5565,12 @@ func (x *CheckResourceReadinessResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckResourceReadinessResponse.ProtoReflect.Descriptor instead.
-func (*CheckResourceReadinessResponse) Descriptor() ([]byte, []int) {
-	return file_proto_cluster_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *CheckResourceReadinessResponse) GetReady() bool {
-	if x != nil {
-		return x.Ready
-	}
-	return false
+// Deprecated: Use AbortMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*AbortMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cluster_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *CheckResourceReadinessResponse) GetError() string {
+func (x *AbortMultipartUploadResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
@@ -1040,18 +5581,44 @@ var File_proto_cluster_proto protoreflect.FileDescriptor
 
 const file_proto_cluster_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/cluster.proto\x12\acluster\x1a\x1fgoogle/protobuf/timestamp.proto\"4\n" +
+	"\x13proto/cluster.proto\x12\acluster\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd7\x01\n" +
 	"\x13ListClustersRequest\x12\x1d\n" +
 	"\n" +
-	"auth_token\x18\x01 \x01(\tR\tauthToken\"D\n" +
+	"auth_token\x18\x01 \x01(\tR\tauthToken\x122\n" +
+	"\bselector\x18\x02 \x01(\v2\x16.cluster.LabelSelectorR\bselector\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x05 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x06 \x01(\tR\aorderBy\"N\n" +
+	"\rLabelSelector\x12=\n" +
+	"\frequirements\x18\x01 \x03(\v2\x19.cluster.LabelRequirementR\frequirements\"X\n" +
+	"\x10LabelRequirement\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"l\n" +
 	"\x14ListClustersResponse\x12,\n" +
-	"\bclusters\x18\x01 \x03(\v2\x10.cluster.ClusterR\bclusters\"\x84\x01\n" +
+	"\bclusters\x18\x01 \x03(\v2\x10.cluster.ClusterR\bclusters\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd4\x03\n" +
 	"\aCluster\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06region\x18\x02 \x01(\tR\x06region\x12%\n" +
 	"\x0ecloud_provider\x18\x03 \x01(\tR\rcloudProvider\x12\x16\n" +
 	"\x06bucket\x18\x04 \x01(\tR\x06bucket\x12\x12\n" +
-	"\x04role\x18\x05 \x01(\tR\x04role\"^\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x14\n" +
+	"\x05phase\x18\x06 \x01(\tR\x05phase\x12\x12\n" +
+	"\x04type\x18\a \x01(\tR\x04type\x12\x1a\n" +
+	"\breplicas\x18\b \x01(\x05R\breplicas\x12\x12\n" +
+	"\x04size\x18\t \x01(\tR\x04size\x124\n" +
+	"\x06labels\x18\n" +
+	" \x03(\v2\x1c.cluster.Cluster.LabelsEntryR\x06labels\x12C\n" +
+	"\vannotations\x18\v \x03(\v2!.cluster.Cluster.AnnotationsEntryR\vannotations\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"^\n" +
 	"\x1aVerifyClusterExistsRequest\x12!\n" +
 	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x1d\n" +
 	"\n" +
@@ -1065,14 +5632,81 @@ const file_proto_cluster_proto_rawDesc = "" +
 	"auth_token\x18\x02 \x01(\tR\tauthToken\"a\n" +
 	"\x19GetClusterDetailsResponse\x12.\n" +
 	"\x06config\x18\x01 \x01(\v2\x16.cluster.ClusterConfigR\x06config\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"\xb3\x01\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xc4\x04\n" +
 	"\rClusterConfig\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06region\x18\x02 \x01(\tR\x06region\x12%\n" +
 	"\x0ecloud_provider\x18\x03 \x01(\tR\rcloudProvider\x12\x16\n" +
 	"\x06bucket\x18\x04 \x01(\tR\x06bucket\x12\x12\n" +
 	"\x04role\x18\x05 \x01(\tR\x04role\x12#\n" +
-	"\rcluster_token\x18\x06 \x01(\tR\fclusterToken\"\xc8\x01\n" +
+	"\rcluster_token\x18\x06 \x01(\tR\fclusterToken\x12\x14\n" +
+	"\x05phase\x18\a \x01(\tR\x05phase\x12\x12\n" +
+	"\x04type\x18\b \x01(\tR\x04type\x12\x1a\n" +
+	"\breplicas\x18\t \x01(\x05R\breplicas\x12\x12\n" +
+	"\x04size\x18\n" +
+	" \x01(\tR\x04size\x12:\n" +
+	"\x06labels\x18\v \x03(\v2\".cluster.ClusterConfig.LabelsEntryR\x06labels\x12I\n" +
+	"\vannotations\x18\f \x03(\v2'.cluster.ClusterConfig.AnnotationsEntryR\vannotations\x123\n" +
+	"\abuckets\x18\r \x03(\v2\x19.cluster.BucketAttachmentR\abuckets\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x95\x01\n" +
+	"\x10BucketAttachment\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x18\n" +
+	"\apurpose\x18\x02 \x01(\tR\apurpose\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12;\n" +
+	"\vattached_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"attachedAt\"\xb7\x01\n" +
+	"\x13AttachBucketRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x16\n" +
+	"\x06bucket\x18\x02 \x01(\tR\x06bucket\x12\x18\n" +
+	"\apurpose\x18\x03 \x01(\tR\apurpose\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x18\n" +
+	"\areplace\x18\x05 \x01(\bR\areplace\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x06 \x01(\tR\tauthToken\"g\n" +
+	"\x14AttachBucketResponse\x129\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x19.cluster.BucketAttachmentR\n" +
+	"attachment\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x89\x01\n" +
+	"\x13DetachBucketRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x16\n" +
+	"\x06bucket\x18\x02 \x01(\tR\x06bucket\x12\x18\n" +
+	"\apurpose\x18\x03 \x01(\tR\apurpose\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x04 \x01(\tR\tauthToken\"c\n" +
+	"\x14DetachBucketResponse\x125\n" +
+	"\bdetached\x18\x01 \x03(\v2\x19.cluster.BucketAttachmentR\bdetached\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"]\n" +
+	"\x19ListClusterBucketsRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x02 \x01(\tR\tauthToken\"o\n" +
+	"\x1aListClusterBucketsResponse\x12;\n" +
+	"\vattachments\x18\x01 \x03(\v2\x19.cluster.BucketAttachmentR\vattachments\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xaf\x01\n" +
+	"\x06Region\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0ecloud_provider\x18\x02 \x01(\tR\rcloudProvider\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\bR\tavailable\x12)\n" +
+	"\x05tiers\x18\x05 \x03(\v2\x13.cluster.RegionTierR\x05tiers\"w\n" +
+	"\n" +
+	"RegionTier\x12!\n" +
+	"\fcluster_type\x18\x01 \x01(\tR\vclusterType\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\bR\tavailable\x12(\n" +
+	"\x10credits_per_hour\x18\x03 \x01(\x03R\x0ecreditsPerHour\"Z\n" +
+	"\x12ListRegionsRequest\x12%\n" +
+	"\x0ecloud_provider\x18\x01 \x01(\tR\rcloudProvider\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x02 \x01(\tR\tauthToken\"V\n" +
+	"\x13ListRegionsResponse\x12)\n" +
+	"\aregions\x18\x01 \x03(\v2\x0f.cluster.RegionR\aregions\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xe1\x01\n" +
 	"\x14CreateClusterRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12%\n" +
@@ -1081,51 +5715,396 @@ const file_proto_cluster_proto_rawDesc = "" +
 	"\x06bucket\x18\x05 \x01(\tR\x06bucket\x12\x12\n" +
 	"\x04role\x18\x06 \x01(\tR\x04role\x12\x1d\n" +
 	"\n" +
-	"auth_token\x18\a \x01(\tR\tauthToken\"]\n" +
+	"auth_token\x18\a \x01(\tR\tauthToken\x12\x17\n" +
+	"\akms_key\x18\b \x01(\tR\x06kmsKey\"]\n" +
 	"\x15CreateClusterResponse\x12.\n" +
 	"\x06config\x18\x01 \x01(\v2\x16.cluster.ClusterConfigR\x06config\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"Z\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xfd\x01\n" +
+	"\x13CloneClusterRequest\x12.\n" +
+	"\x13source_cluster_name\x18\x01 \x01(\tR\x11sourceClusterName\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\x16\n" +
+	"\x06bucket\x18\x05 \x01(\tR\x06bucket\x12\x12\n" +
+	"\x04role\x18\x06 \x01(\tR\x04role\x12+\n" +
+	"\x11include_resources\x18\a \x01(\bR\x10includeResources\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\b \x01(\tR\tauthToken\"\x87\x01\n" +
+	"\x14CloneClusterResponse\x12.\n" +
+	"\x06config\x18\x01 \x01(\v2\x16.cluster.ClusterConfigR\x06config\x12)\n" +
+	"\x10copied_resources\x18\x02 \x01(\x05R\x0fcopiedResources\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"~\n" +
+	"\x13WatchClusterRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x02 \x01(\tR\tauthToken\x12%\n" +
+	"\x0eafter_sequence\x18\x03 \x01(\x03R\rafterSequence\"\x8d\x02\n" +
+	"\rClusterStatus\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x14\n" +
+	"\x05phase\x18\x02 \x01(\tR\x05phase\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12)\n" +
+	"\x10progress_percent\x18\x04 \x01(\x05R\x0fprogressPercent\x12\x12\n" +
+	"\x04done\x18\x05 \x01(\bR\x04done\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12\x1a\n" +
+	"\bsequence\x18\a \x01(\x03R\bsequence\x128\n" +
+	"\ttimestamp\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"X\n" +
+	"\x14DeleteClusterRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x02 \x01(\tR\tauthToken\"f\n" +
+	"\x15DeleteClusterResponse\x127\n" +
+	"\toperation\x18\x01 \x01(\v2\x19.cluster.ClusterOperationR\toperation\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xde\x01\n" +
+	"\x14UpdateClusterRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12.\n" +
+	"\x06config\x18\x02 \x01(\v2\x16.cluster.ClusterConfigR\x06config\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x05 \x01(\tR\tauthToken\"]\n" +
+	"\x15UpdateClusterResponse\x12.\n" +
+	"\x06config\x18\x01 \x01(\v2\x16.cluster.ClusterConfigR\x06config\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"p\n" +
+	"\x18ChangeClusterTierRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x03 \x01(\tR\tauthToken\"j\n" +
+	"\x19ChangeClusterTierResponse\x127\n" +
+	"\toperation\x18\x01 \x01(\v2\x19.cluster.ClusterOperationR\toperation\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"W\n" +
+	"\x13PauseClusterRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x02 \x01(\tR\tauthToken\"e\n" +
+	"\x14PauseClusterResponse\x127\n" +
+	"\toperation\x18\x01 \x01(\v2\x19.cluster.ClusterOperationR\toperation\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"X\n" +
+	"\x14ResumeClusterRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x02 \x01(\tR\tauthToken\"f\n" +
+	"\x15ResumeClusterResponse\x127\n" +
+	"\toperation\x18\x01 \x01(\v2\x19.cluster.ClusterOperationR\toperation\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x87\x01\n" +
+	"\x13ScaleClusterRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x1a\n" +
+	"\breplicas\x18\x02 \x01(\x05R\breplicas\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\tR\x04size\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x04 \x01(\tR\tauthToken\"e\n" +
+	"\x14ScaleClusterResponse\x127\n" +
+	"\toperation\x18\x01 \x01(\v2\x19.cluster.ClusterOperationR\toperation\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x8a\x02\n" +
+	"\x11ListEventsRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x120\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x04 \x01(\tR\tauthToken\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\a \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\b \x01(\tR\aorderBy\"z\n" +
+	"\x12ListEventsResponse\x12&\n" +
+	"\x06events\x18\x01 \x03(\v2\x0e.cluster.EventR\x06events\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xa9\x01\n" +
+	"\x12WatchEventsRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12%\n" +
+	"\x0eafter_sequence\x18\x03 \x01(\x03R\rafterSequence\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x04 \x01(\tR\tauthToken\x12\x16\n" +
+	"\x06filter\x18\x05 \x01(\tR\x06filter\"\x8e\x02\n" +
+	"\x05Event\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fcluster_name\x18\x02 \x01(\tR\vclusterName\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bresource\x18\x05 \x01(\tR\bresource\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12\x1a\n" +
+	"\bsequence\x18\b \x01(\x03R\bsequence\x128\n" +
+	"\ttimestamp\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"Z\n" +
+	"\x16ExportResourcesRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x02 \x01(\tR\tauthToken\"d\n" +
+	"\x17ExportResourcesResponse\x123\n" +
+	"\tresources\x18\x01 \x03(\v2\x15.cluster.ResourceSpecR\tresources\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xa9\x01\n" +
+	"\x14ApplyResourceRequest\x12!\n" +
+	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x121\n" +
+	"\bresource\x18\x02 \x01(\v2\x15.cluster.ResourceSpecR\bresource\x12\x1c\n" +
+	"\toverwrite\x18\x03 \x01(\bR\toverwrite\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x04 \x01(\tR\tauthToken\"E\n" +
+	"\x15ApplyResourceResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"i\n" +
+	"\fResourceSpec\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\x03 \x03(\tR\tdependsOn\x12\x12\n" +
+	"\x04spec\x18\x04 \x01(\tR\x04spec\"^\n" +
+	"\x1aGetClusterOperationRequest\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x02 \x01(\tR\tauthToken\"l\n" +
+	"\x1bGetClusterOperationResponse\x127\n" +
+	"\toperation\x18\x01 \x01(\v2\x19.cluster.ClusterOperationR\toperation\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xd4\x02\n" +
+	"\x10ClusterOperation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fcluster_name\x18\x02 \x01(\tR\vclusterName\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05phase\x18\x04 \x01(\tR\x05phase\x12)\n" +
+	"\x10progress_percent\x18\x05 \x01(\x05R\x0fprogressPercent\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12\x12\n" +
+	"\x04done\x18\a \x01(\bR\x04done\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"started_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xfd\x01\n" +
 	"\x12ListBucketsRequest\x12%\n" +
 	"\x0ecloud_provider\x18\x01 \x01(\tR\rcloudProvider\x12\x1d\n" +
 	"\n" +
-	"auth_token\x18\x02 \x01(\tR\tauthToken\"@\n" +
+	"auth_token\x18\x02 \x01(\tR\tauthToken\x122\n" +
+	"\bselector\x18\x03 \x01(\v2\x16.cluster.LabelSelectorR\bselector\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06filter\x18\x06 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\a \x01(\tR\aorderBy\"h\n" +
 	"\x13ListBucketsResponse\x12)\n" +
-	"\abuckets\x18\x01 \x03(\v2\x0f.cluster.BucketR\abuckets\"\x9f\x01\n" +
+	"\abuckets\x18\x01 \x03(\v2\x0f.cluster.BucketR\abuckets\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb3\x04\n" +
 	"\x06Bucket\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06region\x18\x02 \x01(\tR\x06region\x12\x1a\n" +
 	"\bprovider\x18\x03 \x01(\tR\bprovider\x12\x12\n" +
 	"\x04size\x18\x04 \x01(\tR\x04size\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8d\x01\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x123\n" +
+	"\x06labels\x18\x06 \x03(\v2\x1b.cluster.Bucket.LabelsEntryR\x06labels\x12B\n" +
+	"\vannotations\x18\a \x03(\v2 .cluster.Bucket.AnnotationsEntryR\vannotations\x12#\n" +
+	"\rstorage_class\x18\b \x01(\tR\fstorageClass\x12\x1e\n" +
+	"\n" +
+	"versioning\x18\t \x01(\bR\n" +
+	"versioning\x12@\n" +
+	"\rs3_compatible\x18\n" +
+	" \x01(\v2\x1b.cluster.S3CompatibleConfigR\fs3Compatible\x12\x17\n" +
+	"\akms_key\x18\v \x01(\tR\x06kmsKey\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xde\x01\n" +
+	"\x12S3CompatibleConfig\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x1d\n" +
+	"\n" +
+	"path_style\x18\x02 \x01(\bR\tpathStyle\x12 \n" +
+	"\vcredentials\x18\x03 \x01(\tR\vcredentials\x12-\n" +
+	"\x12credentials_secret\x18\x04 \x01(\tR\x11credentialsSecret\x12\x19\n" +
+	"\brole_arn\x18\x05 \x01(\tR\aroleArn\x12!\n" +
+	"\fsts_endpoint\x18\x06 \x01(\tR\vstsEndpoint\"\x8d\x01\n" +
 	"\x19VerifyBucketAccessRequest\x12%\n" +
 	"\x0ecloud_provider\x18\x01 \x01(\tR\rcloudProvider\x12\x16\n" +
 	"\x06bucket\x18\x02 \x01(\tR\x06bucket\x12\x12\n" +
 	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1d\n" +
 	"\n" +
-	"auth_token\x18\x04 \x01(\tR\tauthToken\"Q\n" +
+	"auth_token\x18\x04 \x01(\tR\tauthToken\"\x7f\n" +
 	"\x1aVerifyBucketAccessResponse\x12\x1d\n" +
 	"\n" +
 	"has_access\x18\x01 \x01(\bR\thasAccess\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"\x91\x01\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12,\n" +
+	"\x06checks\x18\x03 \x03(\v2\x14.cluster.AccessCheckR\x06checks\"r\n" +
+	"\vAccessCheck\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\tR\terrorCode\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"]\n" +
+	"\x15GetServiceRoleRequest\x12%\n" +
+	"\x0ecloud_provider\x18\x01 \x01(\tR\rcloudProvider\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x02 \x01(\tR\tauthToken\"\x8a\x01\n" +
+	"\x16GetServiceRoleResponse\x12!\n" +
+	"\fservice_role\x18\x01 \x01(\tR\vserviceRole\x12\x1f\n" +
+	"\vexternal_id\x18\x02 \x01(\tR\n" +
+	"externalId\x12\x16\n" +
+	"\x06issuer\x18\x03 \x01(\tR\x06issuer\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xaa\x01\n" +
 	"\x1dCheckResourceReadinessRequest\x12%\n" +
 	"\x0ecloud_provider\x18\x01 \x01(\tR\rcloudProvider\x12\x16\n" +
 	"\x06bucket\x18\x02 \x01(\tR\x06bucket\x12\x12\n" +
 	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1d\n" +
 	"\n" +
-	"auth_token\x18\x04 \x01(\tR\tauthToken\"L\n" +
+	"auth_token\x18\x04 \x01(\tR\tauthToken\x12\x17\n" +
+	"\akms_key\x18\x05 \x01(\tR\x06kmsKey\"z\n" +
 	"\x1eCheckResourceReadinessResponse\x12\x14\n" +
 	"\x05ready\x18\x01 \x01(\bR\x05ready\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error2\xf3\x02\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12,\n" +
+	"\x06checks\x18\x03 \x03(\v2\x14.cluster.AccessCheckR\x06checks\"\xa4\x03\n" +
+	"\x13CreateBucketRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0ecloud_provider\x18\x02 \x01(\tR\rcloudProvider\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12#\n" +
+	"\rstorage_class\x18\x04 \x01(\tR\fstorageClass\x12\x1e\n" +
+	"\n" +
+	"versioning\x18\x05 \x01(\bR\n" +
+	"versioning\x12@\n" +
+	"\x06labels\x18\x06 \x03(\v2(.cluster.CreateBucketRequest.LabelsEntryR\x06labels\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\a \x01(\tR\tauthToken\x12@\n" +
+	"\rs3_compatible\x18\b \x01(\v2\x1b.cluster.S3CompatibleConfigR\fs3Compatible\x12\x17\n" +
+	"\akms_key\x18\t \x01(\tR\x06kmsKey\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"U\n" +
+	"\x14CreateBucketResponse\x12'\n" +
+	"\x06bucket\x18\x01 \x01(\v2\x0f.cluster.BucketR\x06bucket\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"R\n" +
+	"\x10GetBucketRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x02 \x01(\tR\tauthToken\"R\n" +
+	"\x11GetBucketResponse\x12'\n" +
+	"\x06bucket\x18\x01 \x01(\v2\x0f.cluster.BucketR\x06bucket\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"|\n" +
+	"\x13DeleteBucketRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12%\n" +
+	"\x0edelete_objects\x18\x02 \x01(\bR\rdeleteObjects\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x03 \x01(\tR\tauthToken\",\n" +
+	"\x14DeleteBucketResponse\x12\x14\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\"\xbb\x01\n" +
+	"\x13UpdateBucketRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12'\n" +
+	"\x06bucket\x18\x02 \x01(\v2\x0f.cluster.BucketR\x06bucket\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x04 \x01(\tR\tauthToken\"U\n" +
+	"\x14UpdateBucketResponse\x12'\n" +
+	"\x06bucket\x18\x01 \x01(\v2\x0f.cluster.BucketR\x06bucket\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xb5\x01\n" +
+	"\x14SignObjectURLRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x04 \x01(\tR\tauthToken\x12\x1b\n" +
+	"\tupload_id\x18\x05 \x01(\tR\buploadId\x12\x1f\n" +
+	"\vpart_number\x18\x06 \x01(\x05R\n" +
+	"partNumber\"\xfd\x01\n" +
+	"\x15SignObjectURLResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12E\n" +
+	"\aheaders\x18\x02 \x03(\v2+.cluster.SignObjectURLResponse.HeadersEntryR\aheaders\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbd\x01\n" +
+	"\x12ListObjectsRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x1c\n" +
+	"\tdelimiter\x18\x03 \x01(\tR\tdelimiter\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x06 \x01(\tR\tauthToken\"\xa7\x01\n" +
+	"\x13ListObjectsResponse\x12)\n" +
+	"\aobjects\x18\x01 \x03(\v2\x0f.cluster.ObjectR\aobjects\x12'\n" +
+	"\x0fcommon_prefixes\x18\x02 \x03(\tR\x0ecommonPrefixes\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xa8\x01\n" +
+	"\x06Object\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12?\n" +
+	"\rlast_modified\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\flastModified\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\x12#\n" +
+	"\rstorage_class\x18\x05 \x01(\tR\fstorageClass\"\x89\x01\n" +
+	"\x1bStartMultipartUploadRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x04 \x01(\tR\tauthToken\"Q\n" +
+	"\x1cStartMultipartUploadResponse\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"D\n" +
+	"\rCompletedPart\x12\x1f\n" +
+	"\vpart_number\x18\x01 \x01(\x05R\n" +
+	"partNumber\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"\xb4\x01\n" +
+	"\x1eCompleteMultipartUploadRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1b\n" +
+	"\tupload_id\x18\x03 \x01(\tR\buploadId\x12,\n" +
+	"\x05parts\x18\x04 \x03(\v2\x16.cluster.CompletedPartR\x05parts\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x05 \x01(\tR\tauthToken\"`\n" +
+	"\x1fCompleteMultipartUploadResponse\x12'\n" +
+	"\x06object\x18\x01 \x01(\v2\x0f.cluster.ObjectR\x06object\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x83\x01\n" +
+	"\x1bAbortMultipartUploadRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1b\n" +
+	"\tupload_id\x18\x03 \x01(\tR\buploadId\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x04 \x01(\tR\tauthToken\"4\n" +
+	"\x1cAbortMultipartUploadResponse\x12\x14\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error2\xe0\r\n" +
 	"\x0eClusterService\x12M\n" +
 	"\fListClusters\x12\x1c.cluster.ListClustersRequest\x1a\x1d.cluster.ListClustersResponse\"\x00\x12b\n" +
 	"\x13VerifyClusterExists\x12#.cluster.VerifyClusterExistsRequest\x1a$.cluster.VerifyClusterExistsResponse\"\x00\x12\\\n" +
 	"\x11GetClusterDetails\x12!.cluster.GetClusterDetailsRequest\x1a\".cluster.GetClusterDetailsResponse\"\x00\x12P\n" +
-	"\rCreateCluster\x12\x1d.cluster.CreateClusterRequest\x1a\x1e.cluster.CreateClusterResponse\"\x002\xa9\x02\n" +
+	"\rCreateCluster\x12\x1d.cluster.CreateClusterRequest\x1a\x1e.cluster.CreateClusterResponse\"\x00\x12M\n" +
+	"\fCloneCluster\x12\x1c.cluster.CloneClusterRequest\x1a\x1d.cluster.CloneClusterResponse\"\x00\x12H\n" +
+	"\fWatchCluster\x12\x1c.cluster.WatchClusterRequest\x1a\x16.cluster.ClusterStatus\"\x000\x01\x12P\n" +
+	"\rDeleteCluster\x12\x1d.cluster.DeleteClusterRequest\x1a\x1e.cluster.DeleteClusterResponse\"\x00\x12P\n" +
+	"\rUpdateCluster\x12\x1d.cluster.UpdateClusterRequest\x1a\x1e.cluster.UpdateClusterResponse\"\x00\x12\\\n" +
+	"\x11ChangeClusterTier\x12!.cluster.ChangeClusterTierRequest\x1a\".cluster.ChangeClusterTierResponse\"\x00\x12b\n" +
+	"\x13GetClusterOperation\x12#.cluster.GetClusterOperationRequest\x1a$.cluster.GetClusterOperationResponse\"\x00\x12M\n" +
+	"\fPauseCluster\x12\x1c.cluster.PauseClusterRequest\x1a\x1d.cluster.PauseClusterResponse\"\x00\x12P\n" +
+	"\rResumeCluster\x12\x1d.cluster.ResumeClusterRequest\x1a\x1e.cluster.ResumeClusterResponse\"\x00\x12M\n" +
+	"\fScaleCluster\x12\x1c.cluster.ScaleClusterRequest\x1a\x1d.cluster.ScaleClusterResponse\"\x00\x12G\n" +
+	"\n" +
+	"ListEvents\x12\x1a.cluster.ListEventsRequest\x1a\x1b.cluster.ListEventsResponse\"\x00\x12>\n" +
+	"\vWatchEvents\x12\x1b.cluster.WatchEventsRequest\x1a\x0e.cluster.Event\"\x000\x01\x12V\n" +
+	"\x0fExportResources\x12\x1f.cluster.ExportResourcesRequest\x1a .cluster.ExportResourcesResponse\"\x00\x12P\n" +
+	"\rApplyResource\x12\x1d.cluster.ApplyResourceRequest\x1a\x1e.cluster.ApplyResourceResponse\"\x00\x12M\n" +
+	"\fAttachBucket\x12\x1c.cluster.AttachBucketRequest\x1a\x1d.cluster.AttachBucketResponse\"\x00\x12M\n" +
+	"\fDetachBucket\x12\x1c.cluster.DetachBucketRequest\x1a\x1d.cluster.DetachBucketResponse\"\x00\x12_\n" +
+	"\x12ListClusterBuckets\x12\".cluster.ListClusterBucketsRequest\x1a#.cluster.ListClusterBucketsResponse\"\x00\x12J\n" +
+	"\vListRegions\x12\x1b.cluster.ListRegionsRequest\x1a\x1c.cluster.ListRegionsResponse\"\x002\x8d\t\n" +
 	"\rBucketService\x12J\n" +
 	"\vListBuckets\x12\x1b.cluster.ListBucketsRequest\x1a\x1c.cluster.ListBucketsResponse\"\x00\x12_\n" +
 	"\x12VerifyBucketAccess\x12\".cluster.VerifyBucketAccessRequest\x1a#.cluster.VerifyBucketAccessResponse\"\x00\x12k\n" +
-	"\x16CheckResourceReadiness\x12&.cluster.CheckResourceReadinessRequest\x1a'.cluster.CheckResourceReadinessResponse\"\x00B;Z9github.com/nstream-ai/nstream-ai-mothership/proto/clusterb\x06proto3"
+	"\x16CheckResourceReadiness\x12&.cluster.CheckResourceReadinessRequest\x1a'.cluster.CheckResourceReadinessResponse\"\x00\x12S\n" +
+	"\x0eGetServiceRole\x12\x1e.cluster.GetServiceRoleRequest\x1a\x1f.cluster.GetServiceRoleResponse\"\x00\x12M\n" +
+	"\fCreateBucket\x12\x1c.cluster.CreateBucketRequest\x1a\x1d.cluster.CreateBucketResponse\"\x00\x12D\n" +
+	"\tGetBucket\x12\x19.cluster.GetBucketRequest\x1a\x1a.cluster.GetBucketResponse\"\x00\x12M\n" +
+	"\fDeleteBucket\x12\x1c.cluster.DeleteBucketRequest\x1a\x1d.cluster.DeleteBucketResponse\"\x00\x12M\n" +
+	"\fUpdateBucket\x12\x1c.cluster.UpdateBucketRequest\x1a\x1d.cluster.UpdateBucketResponse\"\x00\x12P\n" +
+	"\rSignObjectURL\x12\x1d.cluster.SignObjectURLRequest\x1a\x1e.cluster.SignObjectURLResponse\"\x00\x12J\n" +
+	"\vListObjects\x12\x1b.cluster.ListObjectsRequest\x1a\x1c.cluster.ListObjectsResponse\"\x00\x12e\n" +
+	"\x14StartMultipartUpload\x12$.cluster.StartMultipartUploadRequest\x1a%.cluster.StartMultipartUploadResponse\"\x00\x12n\n" +
+	"\x17CompleteMultipartUpload\x12'.cluster.CompleteMultipartUploadRequest\x1a(.cluster.CompleteMultipartUploadResponse\"\x00\x12e\n" +
+	"\x14AbortMultipartUpload\x12$.cluster.AbortMultipartUploadRequest\x1a%.cluster.AbortMultipartUploadResponse\"\x00B;Z9github.com/nstream-ai/nstream-ai-mothership/proto/clusterb\x06proto3"
 
 var (
 	file_proto_cluster_proto_rawDescOnce sync.Once
@@ -1139,52 +6118,230 @@ func file_proto_cluster_proto_rawDescGZIP() []byte {
 	return file_proto_cluster_proto_rawDescData
 }
 
-var file_proto_cluster_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_proto_cluster_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_proto_cluster_proto_goTypes = []any{
-	(*ListClustersRequest)(nil),            // 0: cluster.ListClustersRequest
-	(*ListClustersResponse)(nil),           // 1: cluster.ListClustersResponse
-	(*Cluster)(nil),                        // 2: cluster.Cluster
-	(*VerifyClusterExistsRequest)(nil),     // 3: cluster.VerifyClusterExistsRequest
-	(*VerifyClusterExistsResponse)(nil),    // 4: cluster.VerifyClusterExistsResponse
-	(*GetClusterDetailsRequest)(nil),       // 5: cluster.GetClusterDetailsRequest
-	(*GetClusterDetailsResponse)(nil),      // 6: cluster.GetClusterDetailsResponse
-	(*ClusterConfig)(nil),                  // 7: cluster.ClusterConfig
-	(*CreateClusterRequest)(nil),           // 8: cluster.CreateClusterRequest
-	(*CreateClusterResponse)(nil),          // 9: cluster.CreateClusterResponse
-	(*ListBucketsRequest)(nil),             // 10: cluster.ListBucketsRequest
-	(*ListBucketsResponse)(nil),            // 11: cluster.ListBucketsResponse
-	(*Bucket)(nil),                         // 12: cluster.Bucket
-	(*VerifyBucketAccessRequest)(nil),      // 13: cluster.VerifyBucketAccessRequest
-	(*VerifyBucketAccessResponse)(nil),     // 14: cluster.VerifyBucketAccessResponse
-	(*CheckResourceReadinessRequest)(nil),  // 15: cluster.CheckResourceReadinessRequest
-	(*CheckResourceReadinessResponse)(nil), // 16: cluster.CheckResourceReadinessResponse
-	(*timestamppb.Timestamp)(nil),          // 17: google.protobuf.Timestamp
+	(*ListClustersRequest)(nil),             // 0: cluster.ListClustersRequest
+	(*LabelSelector)(nil),                   // 1: cluster.LabelSelector
+	(*LabelRequirement)(nil),                // 2: cluster.LabelRequirement
+	(*ListClustersResponse)(nil),            // 3: cluster.ListClustersResponse
+	(*Cluster)(nil),                         // 4: cluster.Cluster
+	(*VerifyClusterExistsRequest)(nil),      // 5: cluster.VerifyClusterExistsRequest
+	(*VerifyClusterExistsResponse)(nil),     // 6: cluster.VerifyClusterExistsResponse
+	(*GetClusterDetailsRequest)(nil),        // 7: cluster.GetClusterDetailsRequest
+	(*GetClusterDetailsResponse)(nil),       // 8: cluster.GetClusterDetailsResponse
+	(*ClusterConfig)(nil),                   // 9: cluster.ClusterConfig
+	(*BucketAttachment)(nil),                // 10: cluster.BucketAttachment
+	(*AttachBucketRequest)(nil),             // 11: cluster.AttachBucketRequest
+	(*AttachBucketResponse)(nil),            // 12: cluster.AttachBucketResponse
+	(*DetachBucketRequest)(nil),             // 13: cluster.DetachBucketRequest
+	(*DetachBucketResponse)(nil),            // 14: cluster.DetachBucketResponse
+	(*ListClusterBucketsRequest)(nil),       // 15: cluster.ListClusterBucketsRequest
+	(*ListClusterBucketsResponse)(nil),      // 16: cluster.ListClusterBucketsResponse
+	(*Region)(nil),                          // 17: cluster.Region
+	(*RegionTier)(nil),                      // 18: cluster.RegionTier
+	(*ListRegionsRequest)(nil),              // 19: cluster.ListRegionsRequest
+	(*ListRegionsResponse)(nil),             // 20: cluster.ListRegionsResponse
+	(*CreateClusterRequest)(nil),            // 21: cluster.CreateClusterRequest
+	(*CreateClusterResponse)(nil),           // 22: cluster.CreateClusterResponse
+	(*CloneClusterRequest)(nil),             // 23: cluster.CloneClusterRequest
+	(*CloneClusterResponse)(nil),            // 24: cluster.CloneClusterResponse
+	(*WatchClusterRequest)(nil),             // 25: cluster.WatchClusterRequest
+	(*ClusterStatus)(nil),                   // 26: cluster.ClusterStatus
+	(*DeleteClusterRequest)(nil),            // 27: cluster.DeleteClusterRequest
+	(*DeleteClusterResponse)(nil),           // 28: cluster.DeleteClusterResponse
+	(*UpdateClusterRequest)(nil),            // 29: cluster.UpdateClusterRequest
+	(*UpdateClusterResponse)(nil),           // 30: cluster.UpdateClusterResponse
+	(*ChangeClusterTierRequest)(nil),        // 31: cluster.ChangeClusterTierRequest
+	(*ChangeClusterTierResponse)(nil),       // 32: cluster.ChangeClusterTierResponse
+	(*PauseClusterRequest)(nil),             // 33: cluster.PauseClusterRequest
+	(*PauseClusterResponse)(nil),            // 34: cluster.PauseClusterResponse
+	(*ResumeClusterRequest)(nil),            // 35: cluster.ResumeClusterRequest
+	(*ResumeClusterResponse)(nil),           // 36: cluster.ResumeClusterResponse
+	(*ScaleClusterRequest)(nil),             // 37: cluster.ScaleClusterRequest
+	(*ScaleClusterResponse)(nil),            // 38: cluster.ScaleClusterResponse
+	(*ListEventsRequest)(nil),               // 39: cluster.ListEventsRequest
+	(*ListEventsResponse)(nil),              // 40: cluster.ListEventsResponse
+	(*WatchEventsRequest)(nil),              // 41: cluster.WatchEventsRequest
+	(*Event)(nil),                           // 42: cluster.Event
+	(*ExportResourcesRequest)(nil),          // 43: cluster.ExportResourcesRequest
+	(*ExportResourcesResponse)(nil),         // 44: cluster.ExportResourcesResponse
+	(*ApplyResourceRequest)(nil),            // 45: cluster.ApplyResourceRequest
+	(*ApplyResourceResponse)(nil),           // 46: cluster.ApplyResourceResponse
+	(*ResourceSpec)(nil),                    // 47: cluster.ResourceSpec
+	(*GetClusterOperationRequest)(nil),      // 48: cluster.GetClusterOperationRequest
+	(*GetClusterOperationResponse)(nil),     // 49: cluster.GetClusterOperationResponse
+	(*ClusterOperation)(nil),                // 50: cluster.ClusterOperation
+	(*ListBucketsRequest)(nil),              // 51: cluster.ListBucketsRequest
+	(*ListBucketsResponse)(nil),             // 52: cluster.ListBucketsResponse
+	(*Bucket)(nil),                          // 53: cluster.Bucket
+	(*S3CompatibleConfig)(nil),              // 54: cluster.S3CompatibleConfig
+	(*VerifyBucketAccessRequest)(nil),       // 55: cluster.VerifyBucketAccessRequest
+	(*VerifyBucketAccessResponse)(nil),      // 56: cluster.VerifyBucketAccessResponse
+	(*AccessCheck)(nil),                     // 57: cluster.AccessCheck
+	(*GetServiceRoleRequest)(nil),           // 58: cluster.GetServiceRoleRequest
+	(*GetServiceRoleResponse)(nil),          // 59: cluster.GetServiceRoleResponse
+	(*CheckResourceReadinessRequest)(nil),   // 60: cluster.CheckResourceReadinessRequest
+	(*CheckResourceReadinessResponse)(nil),  // 61: cluster.CheckResourceReadinessResponse
+	(*CreateBucketRequest)(nil),             // 62: cluster.CreateBucketRequest
+	(*CreateBucketResponse)(nil),            // 63: cluster.CreateBucketResponse
+	(*GetBucketRequest)(nil),                // 64: cluster.GetBucketRequest
+	(*GetBucketResponse)(nil),               // 65: cluster.GetBucketResponse
+	(*DeleteBucketRequest)(nil),             // 66: cluster.DeleteBucketRequest
+	(*DeleteBucketResponse)(nil),            // 67: cluster.DeleteBucketResponse
+	(*UpdateBucketRequest)(nil),             // 68: cluster.UpdateBucketRequest
+	(*UpdateBucketResponse)(nil),            // 69: cluster.UpdateBucketResponse
+	(*SignObjectURLRequest)(nil),            // 70: cluster.SignObjectURLRequest
+	(*SignObjectURLResponse)(nil),           // 71: cluster.SignObjectURLResponse
+	(*ListObjectsRequest)(nil),              // 72: cluster.ListObjectsRequest
+	(*ListObjectsResponse)(nil),             // 73: cluster.ListObjectsResponse
+	(*Object)(nil),                          // 74: cluster.Object
+	(*StartMultipartUploadRequest)(nil),     // 75: cluster.StartMultipartUploadRequest
+	(*StartMultipartUploadResponse)(nil),    // 76: cluster.StartMultipartUploadResponse
+	(*CompletedPart)(nil),                   // 77: cluster.CompletedPart
+	(*CompleteMultipartUploadRequest)(nil),  // 78: cluster.CompleteMultipartUploadRequest
+	(*CompleteMultipartUploadResponse)(nil), // 79: cluster.CompleteMultipartUploadResponse
+	(*AbortMultipartUploadRequest)(nil),     // 80: cluster.AbortMultipartUploadRequest
+	(*AbortMultipartUploadResponse)(nil),    // 81: cluster.AbortMultipartUploadResponse
+	nil,                                     // 82: cluster.Cluster.LabelsEntry
+	nil,                                     // 83: cluster.Cluster.AnnotationsEntry
+	nil,                                     // 84: cluster.ClusterConfig.LabelsEntry
+	nil,                                     // 85: cluster.ClusterConfig.AnnotationsEntry
+	nil,                                     // 86: cluster.Bucket.LabelsEntry
+	nil,                                     // 87: cluster.Bucket.AnnotationsEntry
+	nil,                                     // 88: cluster.CreateBucketRequest.LabelsEntry
+	nil,                                     // 89: cluster.SignObjectURLResponse.HeadersEntry
+	(*timestamppb.Timestamp)(nil),           // 90: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),           // 91: google.protobuf.FieldMask
 }
 var file_proto_cluster_proto_depIdxs = []int32{
-	2,  // 0: cluster.ListClustersResponse.clusters:type_name -> cluster.Cluster
-	7,  // 1: cluster.GetClusterDetailsResponse.config:type_name -> cluster.ClusterConfig
-	7,  // 2: cluster.CreateClusterResponse.config:type_name -> cluster.ClusterConfig
-	12, // 3: cluster.ListBucketsResponse.buckets:type_name -> cluster.Bucket
-	17, // 4: cluster.Bucket.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 5: cluster.ClusterService.ListClusters:input_type -> cluster.ListClustersRequest
-	3,  // 6: cluster.ClusterService.VerifyClusterExists:input_type -> cluster.VerifyClusterExistsRequest
-	5,  // 7: cluster.ClusterService.GetClusterDetails:input_type -> cluster.GetClusterDetailsRequest
-	8,  // 8: cluster.ClusterService.CreateCluster:input_type -> cluster.CreateClusterRequest
-	10, // 9: cluster.BucketService.ListBuckets:input_type -> cluster.ListBucketsRequest
-	13, // 10: cluster.BucketService.VerifyBucketAccess:input_type -> cluster.VerifyBucketAccessRequest
-	15, // 11: cluster.BucketService.CheckResourceReadiness:input_type -> cluster.CheckResourceReadinessRequest
-	1,  // 12: cluster.ClusterService.ListClusters:output_type -> cluster.ListClustersResponse
-	4,  // 13: cluster.ClusterService.VerifyClusterExists:output_type -> cluster.VerifyClusterExistsResponse
-	6,  // 14: cluster.ClusterService.GetClusterDetails:output_type -> cluster.GetClusterDetailsResponse
-	9,  // 15: cluster.ClusterService.CreateCluster:output_type -> cluster.CreateClusterResponse
-	11, // 16: cluster.BucketService.ListBuckets:output_type -> cluster.ListBucketsResponse
-	14, // 17: cluster.BucketService.VerifyBucketAccess:output_type -> cluster.VerifyBucketAccessResponse
-	16, // 18: cluster.BucketService.CheckResourceReadiness:output_type -> cluster.CheckResourceReadinessResponse
-	12, // [12:19] is the sub-list for method output_type
-	5,  // [5:12] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	1,  // 0: cluster.ListClustersRequest.selector:type_name -> cluster.LabelSelector
+	2,  // 1: cluster.LabelSelector.requirements:type_name -> cluster.LabelRequirement
+	4,  // 2: cluster.ListClustersResponse.clusters:type_name -> cluster.Cluster
+	82, // 3: cluster.Cluster.labels:type_name -> cluster.Cluster.LabelsEntry
+	83, // 4: cluster.Cluster.annotations:type_name -> cluster.Cluster.AnnotationsEntry
+	9,  // 5: cluster.GetClusterDetailsResponse.config:type_name -> cluster.ClusterConfig
+	84, // 6: cluster.ClusterConfig.labels:type_name -> cluster.ClusterConfig.LabelsEntry
+	85, // 7: cluster.ClusterConfig.annotations:type_name -> cluster.ClusterConfig.AnnotationsEntry
+	10, // 8: cluster.ClusterConfig.buckets:type_name -> cluster.BucketAttachment
+	90, // 9: cluster.BucketAttachment.attached_at:type_name -> google.protobuf.Timestamp
+	10, // 10: cluster.AttachBucketResponse.attachment:type_name -> cluster.BucketAttachment
+	10, // 11: cluster.DetachBucketResponse.detached:type_name -> cluster.BucketAttachment
+	10, // 12: cluster.ListClusterBucketsResponse.attachments:type_name -> cluster.BucketAttachment
+	18, // 13: cluster.Region.tiers:type_name -> cluster.RegionTier
+	17, // 14: cluster.ListRegionsResponse.regions:type_name -> cluster.Region
+	9,  // 15: cluster.CreateClusterResponse.config:type_name -> cluster.ClusterConfig
+	9,  // 16: cluster.CloneClusterResponse.config:type_name -> cluster.ClusterConfig
+	90, // 17: cluster.ClusterStatus.timestamp:type_name -> google.protobuf.Timestamp
+	50, // 18: cluster.DeleteClusterResponse.operation:type_name -> cluster.ClusterOperation
+	9,  // 19: cluster.UpdateClusterRequest.config:type_name -> cluster.ClusterConfig
+	91, // 20: cluster.UpdateClusterRequest.update_mask:type_name -> google.protobuf.FieldMask
+	9,  // 21: cluster.UpdateClusterResponse.config:type_name -> cluster.ClusterConfig
+	50, // 22: cluster.ChangeClusterTierResponse.operation:type_name -> cluster.ClusterOperation
+	50, // 23: cluster.PauseClusterResponse.operation:type_name -> cluster.ClusterOperation
+	50, // 24: cluster.ResumeClusterResponse.operation:type_name -> cluster.ClusterOperation
+	50, // 25: cluster.ScaleClusterResponse.operation:type_name -> cluster.ClusterOperation
+	90, // 26: cluster.ListEventsRequest.since:type_name -> google.protobuf.Timestamp
+	42, // 27: cluster.ListEventsResponse.events:type_name -> cluster.Event
+	90, // 28: cluster.Event.timestamp:type_name -> google.protobuf.Timestamp
+	47, // 29: cluster.ExportResourcesResponse.resources:type_name -> cluster.ResourceSpec
+	47, // 30: cluster.ApplyResourceRequest.resource:type_name -> cluster.ResourceSpec
+	50, // 31: cluster.GetClusterOperationResponse.operation:type_name -> cluster.ClusterOperation
+	90, // 32: cluster.ClusterOperation.started_at:type_name -> google.protobuf.Timestamp
+	90, // 33: cluster.ClusterOperation.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 34: cluster.ListBucketsRequest.selector:type_name -> cluster.LabelSelector
+	53, // 35: cluster.ListBucketsResponse.buckets:type_name -> cluster.Bucket
+	90, // 36: cluster.Bucket.created_at:type_name -> google.protobuf.Timestamp
+	86, // 37: cluster.Bucket.labels:type_name -> cluster.Bucket.LabelsEntry
+	87, // 38: cluster.Bucket.annotations:type_name -> cluster.Bucket.AnnotationsEntry
+	54, // 39: cluster.Bucket.s3_compatible:type_name -> cluster.S3CompatibleConfig
+	57, // 40: cluster.VerifyBucketAccessResponse.checks:type_name -> cluster.AccessCheck
+	57, // 41: cluster.CheckResourceReadinessResponse.checks:type_name -> cluster.AccessCheck
+	88, // 42: cluster.CreateBucketRequest.labels:type_name -> cluster.CreateBucketRequest.LabelsEntry
+	54, // 43: cluster.CreateBucketRequest.s3_compatible:type_name -> cluster.S3CompatibleConfig
+	53, // 44: cluster.CreateBucketResponse.bucket:type_name -> cluster.Bucket
+	53, // 45: cluster.GetBucketResponse.bucket:type_name -> cluster.Bucket
+	53, // 46: cluster.UpdateBucketRequest.bucket:type_name -> cluster.Bucket
+	91, // 47: cluster.UpdateBucketRequest.update_mask:type_name -> google.protobuf.FieldMask
+	53, // 48: cluster.UpdateBucketResponse.bucket:type_name -> cluster.Bucket
+	89, // 49: cluster.SignObjectURLResponse.headers:type_name -> cluster.SignObjectURLResponse.HeadersEntry
+	90, // 50: cluster.SignObjectURLResponse.expires_at:type_name -> google.protobuf.Timestamp
+	74, // 51: cluster.ListObjectsResponse.objects:type_name -> cluster.Object
+	90, // 52: cluster.Object.last_modified:type_name -> google.protobuf.Timestamp
+	77, // 53: cluster.CompleteMultipartUploadRequest.parts:type_name -> cluster.CompletedPart
+	74, // 54: cluster.CompleteMultipartUploadResponse.object:type_name -> cluster.Object
+	0,  // 55: cluster.ClusterService.ListClusters:input_type -> cluster.ListClustersRequest
+	5,  // 56: cluster.ClusterService.VerifyClusterExists:input_type -> cluster.VerifyClusterExistsRequest
+	7,  // 57: cluster.ClusterService.GetClusterDetails:input_type -> cluster.GetClusterDetailsRequest
+	21, // 58: cluster.ClusterService.CreateCluster:input_type -> cluster.CreateClusterRequest
+	23, // 59: cluster.ClusterService.CloneCluster:input_type -> cluster.CloneClusterRequest
+	25, // 60: cluster.ClusterService.WatchCluster:input_type -> cluster.WatchClusterRequest
+	27, // 61: cluster.ClusterService.DeleteCluster:input_type -> cluster.DeleteClusterRequest
+	29, // 62: cluster.ClusterService.UpdateCluster:input_type -> cluster.UpdateClusterRequest
+	31, // 63: cluster.ClusterService.ChangeClusterTier:input_type -> cluster.ChangeClusterTierRequest
+	48, // 64: cluster.ClusterService.GetClusterOperation:input_type -> cluster.GetClusterOperationRequest
+	33, // 65: cluster.ClusterService.PauseCluster:input_type -> cluster.PauseClusterRequest
+	35, // 66: cluster.ClusterService.ResumeCluster:input_type -> cluster.ResumeClusterRequest
+	37, // 67: cluster.ClusterService.ScaleCluster:input_type -> cluster.ScaleClusterRequest
+	39, // 68: cluster.ClusterService.ListEvents:input_type -> cluster.ListEventsRequest
+	41, // 69: cluster.ClusterService.WatchEvents:input_type -> cluster.WatchEventsRequest
+	43, // 70: cluster.ClusterService.ExportResources:input_type -> cluster.ExportResourcesRequest
+	45, // 71: cluster.ClusterService.ApplyResource:input_type -> cluster.ApplyResourceRequest
+	11, // 72: cluster.ClusterService.AttachBucket:input_type -> cluster.AttachBucketRequest
+	13, // 73: cluster.ClusterService.DetachBucket:input_type -> cluster.DetachBucketRequest
+	15, // 74: cluster.ClusterService.ListClusterBuckets:input_type -> cluster.ListClusterBucketsRequest
+	19, // 75: cluster.ClusterService.ListRegions:input_type -> cluster.ListRegionsRequest
+	51, // 76: cluster.BucketService.ListBuckets:input_type -> cluster.ListBucketsRequest
+	55, // 77: cluster.BucketService.VerifyBucketAccess:input_type -> cluster.VerifyBucketAccessRequest
+	60, // 78: cluster.BucketService.CheckResourceReadiness:input_type -> cluster.CheckResourceReadinessRequest
+	58, // 79: cluster.BucketService.GetServiceRole:input_type -> cluster.GetServiceRoleRequest
+	62, // 80: cluster.BucketService.CreateBucket:input_type -> cluster.CreateBucketRequest
+	64, // 81: cluster.BucketService.GetBucket:input_type -> cluster.GetBucketRequest
+	66, // 82: cluster.BucketService.DeleteBucket:input_type -> cluster.DeleteBucketRequest
+	68, // 83: cluster.BucketService.UpdateBucket:input_type -> cluster.UpdateBucketRequest
+	70, // 84: cluster.BucketService.SignObjectURL:input_type -> cluster.SignObjectURLRequest
+	72, // 85: cluster.BucketService.ListObjects:input_type -> cluster.ListObjectsRequest
+	75, // 86: cluster.BucketService.StartMultipartUpload:input_type -> cluster.StartMultipartUploadRequest
+	78, // 87: cluster.BucketService.CompleteMultipartUpload:input_type -> cluster.CompleteMultipartUploadRequest
+	80, // 88: cluster.BucketService.AbortMultipartUpload:input_type -> cluster.AbortMultipartUploadRequest
+	3,  // 89: cluster.ClusterService.ListClusters:output_type -> cluster.ListClustersResponse
+	6,  // 90: cluster.ClusterService.VerifyClusterExists:output_type -> cluster.VerifyClusterExistsResponse
+	8,  // 91: cluster.ClusterService.GetClusterDetails:output_type -> cluster.GetClusterDetailsResponse
+	22, // 92: cluster.ClusterService.CreateCluster:output_type -> cluster.CreateClusterResponse
+	24, // 93: cluster.ClusterService.CloneCluster:output_type -> cluster.CloneClusterResponse
+	26, // 94: cluster.ClusterService.WatchCluster:output_type -> cluster.ClusterStatus
+	28, // 95: cluster.ClusterService.DeleteCluster:output_type -> cluster.DeleteClusterResponse
+	30, // 96: cluster.ClusterService.UpdateCluster:output_type -> cluster.UpdateClusterResponse
+	32, // 97: cluster.ClusterService.ChangeClusterTier:output_type -> cluster.ChangeClusterTierResponse
+	49, // 98: cluster.ClusterService.GetClusterOperation:output_type -> cluster.GetClusterOperationResponse
+	34, // 99: cluster.ClusterService.PauseCluster:output_type -> cluster.PauseClusterResponse
+	36, // 100: cluster.ClusterService.ResumeCluster:output_type -> cluster.ResumeClusterResponse
+	38, // 101: cluster.ClusterService.ScaleCluster:output_type -> cluster.ScaleClusterResponse
+	40, // 102: cluster.ClusterService.ListEvents:output_type -> cluster.ListEventsResponse
+	42, // 103: cluster.ClusterService.WatchEvents:output_type -> cluster.Event
+	44, // 104: cluster.ClusterService.ExportResources:output_type -> cluster.ExportResourcesResponse
+	46, // 105: cluster.ClusterService.ApplyResource:output_type -> cluster.ApplyResourceResponse
+	12, // 106: cluster.ClusterService.AttachBucket:output_type -> cluster.AttachBucketResponse
+	14, // 107: cluster.ClusterService.DetachBucket:output_type -> cluster.DetachBucketResponse
+	16, // 108: cluster.ClusterService.ListClusterBuckets:output_type -> cluster.ListClusterBucketsResponse
+	20, // 109: cluster.ClusterService.ListRegions:output_type -> cluster.ListRegionsResponse
+	52, // 110: cluster.BucketService.ListBuckets:output_type -> cluster.ListBucketsResponse
+	56, // 111: cluster.BucketService.VerifyBucketAccess:output_type -> cluster.VerifyBucketAccessResponse
+	61, // 112: cluster.BucketService.CheckResourceReadiness:output_type -> cluster.CheckResourceReadinessResponse
+	59, // 113: cluster.BucketService.GetServiceRole:output_type -> cluster.GetServiceRoleResponse
+	63, // 114: cluster.BucketService.CreateBucket:output_type -> cluster.CreateBucketResponse
+	65, // 115: cluster.BucketService.GetBucket:output_type -> cluster.GetBucketResponse
+	67, // 116: cluster.BucketService.DeleteBucket:output_type -> cluster.DeleteBucketResponse
+	69, // 117: cluster.BucketService.UpdateBucket:output_type -> cluster.UpdateBucketResponse
+	71, // 118: cluster.BucketService.SignObjectURL:output_type -> cluster.SignObjectURLResponse
+	73, // 119: cluster.BucketService.ListObjects:output_type -> cluster.ListObjectsResponse
+	76, // 120: cluster.BucketService.StartMultipartUpload:output_type -> cluster.StartMultipartUploadResponse
+	79, // 121: cluster.BucketService.CompleteMultipartUpload:output_type -> cluster.CompleteMultipartUploadResponse
+	81, // 122: cluster.BucketService.AbortMultipartUpload:output_type -> cluster.AbortMultipartUploadResponse
+	89, // [89:123] is the sub-list for method output_type
+	55, // [55:89] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_proto_cluster_proto_init() }
@@ -1198,7 +6355,7 @@ func file_proto_cluster_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_cluster_proto_rawDesc), len(file_proto_cluster_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
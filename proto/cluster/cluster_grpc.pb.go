@@ -23,6 +23,23 @@ const (
 	ClusterService_VerifyClusterExists_FullMethodName = "/cluster.ClusterService/VerifyClusterExists"
 	ClusterService_GetClusterDetails_FullMethodName   = "/cluster.ClusterService/GetClusterDetails"
 	ClusterService_CreateCluster_FullMethodName       = "/cluster.ClusterService/CreateCluster"
+	ClusterService_CloneCluster_FullMethodName        = "/cluster.ClusterService/CloneCluster"
+	ClusterService_WatchCluster_FullMethodName        = "/cluster.ClusterService/WatchCluster"
+	ClusterService_DeleteCluster_FullMethodName       = "/cluster.ClusterService/DeleteCluster"
+	ClusterService_UpdateCluster_FullMethodName       = "/cluster.ClusterService/UpdateCluster"
+	ClusterService_ChangeClusterTier_FullMethodName   = "/cluster.ClusterService/ChangeClusterTier"
+	ClusterService_GetClusterOperation_FullMethodName = "/cluster.ClusterService/GetClusterOperation"
+	ClusterService_PauseCluster_FullMethodName        = "/cluster.ClusterService/PauseCluster"
+	ClusterService_ResumeCluster_FullMethodName       = "/cluster.ClusterService/ResumeCluster"
+	ClusterService_ScaleCluster_FullMethodName        = "/cluster.ClusterService/ScaleCluster"
+	ClusterService_ListEvents_FullMethodName          = "/cluster.ClusterService/ListEvents"
+	ClusterService_WatchEvents_FullMethodName         = "/cluster.ClusterService/WatchEvents"
+	ClusterService_ExportResources_FullMethodName     = "/cluster.ClusterService/ExportResources"
+	ClusterService_ApplyResource_FullMethodName       = "/cluster.ClusterService/ApplyResource"
+	ClusterService_AttachBucket_FullMethodName        = "/cluster.ClusterService/AttachBucket"
+	ClusterService_DetachBucket_FullMethodName        = "/cluster.ClusterService/DetachBucket"
+	ClusterService_ListClusterBuckets_FullMethodName  = "/cluster.ClusterService/ListClusterBuckets"
+	ClusterService_ListRegions_FullMethodName         = "/cluster.ClusterService/ListRegions"
 )
 
 // ClusterServiceClient is the client API for ClusterService service.
@@ -37,8 +54,42 @@ type ClusterServiceClient interface {
 	VerifyClusterExists(ctx context.Context, in *VerifyClusterExistsRequest, opts ...grpc.CallOption) (*VerifyClusterExistsResponse, error)
 	// GetClusterDetails retrieves detailed information about a specific cluster
 	GetClusterDetails(ctx context.Context, in *GetClusterDetailsRequest, opts ...grpc.CallOption) (*GetClusterDetailsResponse, error)
-	// CreateCluster creates a new cluster
+	// CreateCluster starts provisioning a new cluster and returns without waiting for it to become ready
 	CreateCluster(ctx context.Context, in *CreateClusterRequest, opts ...grpc.CallOption) (*CreateClusterResponse, error)
+	// CloneCluster starts provisioning a new cluster with the settings, and optionally the resources, of an existing one
+	CloneCluster(ctx context.Context, in *CloneClusterRequest, opts ...grpc.CallOption) (*CloneClusterResponse, error)
+	// WatchCluster streams the provisioning phase transitions of a cluster
+	WatchCluster(ctx context.Context, in *WatchClusterRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ClusterStatus], error)
+	// DeleteCluster starts the asynchronous deletion of a cluster
+	DeleteCluster(ctx context.Context, in *DeleteClusterRequest, opts ...grpc.CallOption) (*DeleteClusterResponse, error)
+	// UpdateCluster updates the fields of a cluster selected by the update mask
+	UpdateCluster(ctx context.Context, in *UpdateClusterRequest, opts ...grpc.CallOption) (*UpdateClusterResponse, error)
+	// ChangeClusterTier starts migrating a cluster to a different tier
+	ChangeClusterTier(ctx context.Context, in *ChangeClusterTierRequest, opts ...grpc.CallOption) (*ChangeClusterTierResponse, error)
+	// GetClusterOperation retrieves the progress of a long-running cluster operation
+	GetClusterOperation(ctx context.Context, in *GetClusterOperationRequest, opts ...grpc.CallOption) (*GetClusterOperationResponse, error)
+	// PauseCluster starts stopping a cluster's compute while keeping its data
+	PauseCluster(ctx context.Context, in *PauseClusterRequest, opts ...grpc.CallOption) (*PauseClusterResponse, error)
+	// ResumeCluster starts bringing a paused cluster back; progress is streamed by WatchCluster
+	ResumeCluster(ctx context.Context, in *ResumeClusterRequest, opts ...grpc.CallOption) (*ResumeClusterResponse, error)
+	// ScaleCluster starts changing a cluster's replica count or node size
+	ScaleCluster(ctx context.Context, in *ScaleClusterRequest, opts ...grpc.CallOption) (*ScaleClusterResponse, error)
+	// ListEvents retrieves recorded events of a cluster and its resources
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// WatchEvents streams new events of a cluster and its resources as they are recorded
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+	// ExportResources retrieves the specs of every resource defined on a cluster, secrets excluded
+	ExportResources(ctx context.Context, in *ExportResourcesRequest, opts ...grpc.CallOption) (*ExportResourcesResponse, error)
+	// ApplyResource creates a resource on a cluster from its spec, or overwrites an existing one
+	ApplyResource(ctx context.Context, in *ApplyResourceRequest, opts ...grpc.CallOption) (*ApplyResourceResponse, error)
+	// AttachBucket attaches a bucket to a cluster for a purpose
+	AttachBucket(ctx context.Context, in *AttachBucketRequest, opts ...grpc.CallOption) (*AttachBucketResponse, error)
+	// DetachBucket detaches buckets from a cluster
+	DetachBucket(ctx context.Context, in *DetachBucketRequest, opts ...grpc.CallOption) (*DetachBucketResponse, error)
+	// ListClusterBuckets lists the buckets attached to a cluster
+	ListClusterBuckets(ctx context.Context, in *ListClusterBucketsRequest, opts ...grpc.CallOption) (*ListClusterBucketsResponse, error)
+	// ListRegions lists the regions clusters can be created in, with their price per tier
+	ListRegions(ctx context.Context, in *ListRegionsRequest, opts ...grpc.CallOption) (*ListRegionsResponse, error)
 }
 
 type clusterServiceClient struct {
@@ -89,6 +140,194 @@ func (c *clusterServiceClient) CreateCluster(ctx context.Context, in *CreateClus
 	return out, nil
 }
 
+func (c *clusterServiceClient) CloneCluster(ctx context.Context, in *CloneClusterRequest, opts ...grpc.CallOption) (*CloneClusterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneClusterResponse)
+	err := c.cc.Invoke(ctx, ClusterService_CloneCluster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) WatchCluster(ctx context.Context, in *WatchClusterRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ClusterStatus], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ClusterService_ServiceDesc.Streams[0], ClusterService_WatchCluster_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchClusterRequest, ClusterStatus]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClusterService_WatchClusterClient = grpc.ServerStreamingClient[ClusterStatus]
+
+func (c *clusterServiceClient) DeleteCluster(ctx context.Context, in *DeleteClusterRequest, opts ...grpc.CallOption) (*DeleteClusterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteClusterResponse)
+	err := c.cc.Invoke(ctx, ClusterService_DeleteCluster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) UpdateCluster(ctx context.Context, in *UpdateClusterRequest, opts ...grpc.CallOption) (*UpdateClusterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateClusterResponse)
+	err := c.cc.Invoke(ctx, ClusterService_UpdateCluster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ChangeClusterTier(ctx context.Context, in *ChangeClusterTierRequest, opts ...grpc.CallOption) (*ChangeClusterTierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeClusterTierResponse)
+	err := c.cc.Invoke(ctx, ClusterService_ChangeClusterTier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) GetClusterOperation(ctx context.Context, in *GetClusterOperationRequest, opts ...grpc.CallOption) (*GetClusterOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClusterOperationResponse)
+	err := c.cc.Invoke(ctx, ClusterService_GetClusterOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) PauseCluster(ctx context.Context, in *PauseClusterRequest, opts ...grpc.CallOption) (*PauseClusterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseClusterResponse)
+	err := c.cc.Invoke(ctx, ClusterService_PauseCluster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ResumeCluster(ctx context.Context, in *ResumeClusterRequest, opts ...grpc.CallOption) (*ResumeClusterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeClusterResponse)
+	err := c.cc.Invoke(ctx, ClusterService_ResumeCluster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ScaleCluster(ctx context.Context, in *ScaleClusterRequest, opts ...grpc.CallOption) (*ScaleClusterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScaleClusterResponse)
+	err := c.cc.Invoke(ctx, ClusterService_ScaleCluster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, ClusterService_ListEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ClusterService_ServiceDesc.Streams[1], ClusterService_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEventsRequest, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClusterService_WatchEventsClient = grpc.ServerStreamingClient[Event]
+
+func (c *clusterServiceClient) ExportResources(ctx context.Context, in *ExportResourcesRequest, opts ...grpc.CallOption) (*ExportResourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportResourcesResponse)
+	err := c.cc.Invoke(ctx, ClusterService_ExportResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ApplyResource(ctx context.Context, in *ApplyResourceRequest, opts ...grpc.CallOption) (*ApplyResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyResourceResponse)
+	err := c.cc.Invoke(ctx, ClusterService_ApplyResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) AttachBucket(ctx context.Context, in *AttachBucketRequest, opts ...grpc.CallOption) (*AttachBucketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachBucketResponse)
+	err := c.cc.Invoke(ctx, ClusterService_AttachBucket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) DetachBucket(ctx context.Context, in *DetachBucketRequest, opts ...grpc.CallOption) (*DetachBucketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetachBucketResponse)
+	err := c.cc.Invoke(ctx, ClusterService_DetachBucket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ListClusterBuckets(ctx context.Context, in *ListClusterBucketsRequest, opts ...grpc.CallOption) (*ListClusterBucketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClusterBucketsResponse)
+	err := c.cc.Invoke(ctx, ClusterService_ListClusterBuckets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ListRegions(ctx context.Context, in *ListRegionsRequest, opts ...grpc.CallOption) (*ListRegionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRegionsResponse)
+	err := c.cc.Invoke(ctx, ClusterService_ListRegions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServiceServer is the server API for ClusterService service.
 // All implementations must embed UnimplementedClusterServiceServer
 // for forward compatibility.
@@ -101,8 +340,42 @@ type ClusterServiceServer interface {
 	VerifyClusterExists(context.Context, *VerifyClusterExistsRequest) (*VerifyClusterExistsResponse, error)
 	// GetClusterDetails retrieves detailed information about a specific cluster
 	GetClusterDetails(context.Context, *GetClusterDetailsRequest) (*GetClusterDetailsResponse, error)
-	// CreateCluster creates a new cluster
+	// CreateCluster starts provisioning a new cluster and returns without waiting for it to become ready
 	CreateCluster(context.Context, *CreateClusterRequest) (*CreateClusterResponse, error)
+	// CloneCluster starts provisioning a new cluster with the settings, and optionally the resources, of an existing one
+	CloneCluster(context.Context, *CloneClusterRequest) (*CloneClusterResponse, error)
+	// WatchCluster streams the provisioning phase transitions of a cluster
+	WatchCluster(*WatchClusterRequest, grpc.ServerStreamingServer[ClusterStatus]) error
+	// DeleteCluster starts the asynchronous deletion of a cluster
+	DeleteCluster(context.Context, *DeleteClusterRequest) (*DeleteClusterResponse, error)
+	// UpdateCluster updates the fields of a cluster selected by the update mask
+	UpdateCluster(context.Context, *UpdateClusterRequest) (*UpdateClusterResponse, error)
+	// ChangeClusterTier starts migrating a cluster to a different tier
+	ChangeClusterTier(context.Context, *ChangeClusterTierRequest) (*ChangeClusterTierResponse, error)
+	// GetClusterOperation retrieves the progress of a long-running cluster operation
+	GetClusterOperation(context.Context, *GetClusterOperationRequest) (*GetClusterOperationResponse, error)
+	// PauseCluster starts stopping a cluster's compute while keeping its data
+	PauseCluster(context.Context, *PauseClusterRequest) (*PauseClusterResponse, error)
+	// ResumeCluster starts bringing a paused cluster back; progress is streamed by WatchCluster
+	ResumeCluster(context.Context, *ResumeClusterRequest) (*ResumeClusterResponse, error)
+	// ScaleCluster starts changing a cluster's replica count or node size
+	ScaleCluster(context.Context, *ScaleClusterRequest) (*ScaleClusterResponse, error)
+	// ListEvents retrieves recorded events of a cluster and its resources
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// WatchEvents streams new events of a cluster and its resources as they are recorded
+	WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[Event]) error
+	// ExportResources retrieves the specs of every resource defined on a cluster, secrets excluded
+	ExportResources(context.Context, *ExportResourcesRequest) (*ExportResourcesResponse, error)
+	// ApplyResource creates a resource on a cluster from its spec, or overwrites an existing one
+	ApplyResource(context.Context, *ApplyResourceRequest) (*ApplyResourceResponse, error)
+	// AttachBucket attaches a bucket to a cluster for a purpose
+	AttachBucket(context.Context, *AttachBucketRequest) (*AttachBucketResponse, error)
+	// DetachBucket detaches buckets from a cluster
+	DetachBucket(context.Context, *DetachBucketRequest) (*DetachBucketResponse, error)
+	// ListClusterBuckets lists the buckets attached to a cluster
+	ListClusterBuckets(context.Context, *ListClusterBucketsRequest) (*ListClusterBucketsResponse, error)
+	// ListRegions lists the regions clusters can be created in, with their price per tier
+	ListRegions(context.Context, *ListRegionsRequest) (*ListRegionsResponse, error)
 	mustEmbedUnimplementedClusterServiceServer()
 }
 
@@ -125,6 +398,57 @@ func (UnimplementedClusterServiceServer) GetClusterDetails(context.Context, *Get
 func (UnimplementedClusterServiceServer) CreateCluster(context.Context, *CreateClusterRequest) (*CreateClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCluster not implemented")
 }
+func (UnimplementedClusterServiceServer) CloneCluster(context.Context, *CloneClusterRequest) (*CloneClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) WatchCluster(*WatchClusterRequest, grpc.ServerStreamingServer[ClusterStatus]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) DeleteCluster(context.Context, *DeleteClusterRequest) (*DeleteClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) UpdateCluster(context.Context, *UpdateClusterRequest) (*UpdateClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) ChangeClusterTier(context.Context, *ChangeClusterTierRequest) (*ChangeClusterTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeClusterTier not implemented")
+}
+func (UnimplementedClusterServiceServer) GetClusterOperation(context.Context, *GetClusterOperationRequest) (*GetClusterOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterOperation not implemented")
+}
+func (UnimplementedClusterServiceServer) PauseCluster(context.Context, *PauseClusterRequest) (*PauseClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) ResumeCluster(context.Context, *ResumeClusterRequest) (*ResumeClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) ScaleCluster(context.Context, *ScaleClusterRequest) (*ScaleClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScaleCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedClusterServiceServer) WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[Event]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedClusterServiceServer) ExportResources(context.Context, *ExportResourcesRequest) (*ExportResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportResources not implemented")
+}
+func (UnimplementedClusterServiceServer) ApplyResource(context.Context, *ApplyResourceRequest) (*ApplyResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyResource not implemented")
+}
+func (UnimplementedClusterServiceServer) AttachBucket(context.Context, *AttachBucketRequest) (*AttachBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachBucket not implemented")
+}
+func (UnimplementedClusterServiceServer) DetachBucket(context.Context, *DetachBucketRequest) (*DetachBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachBucket not implemented")
+}
+func (UnimplementedClusterServiceServer) ListClusterBuckets(context.Context, *ListClusterBucketsRequest) (*ListClusterBucketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusterBuckets not implemented")
+}
+func (UnimplementedClusterServiceServer) ListRegions(context.Context, *ListRegionsRequest) (*ListRegionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegions not implemented")
+}
 func (UnimplementedClusterServiceServer) mustEmbedUnimplementedClusterServiceServer() {}
 func (UnimplementedClusterServiceServer) testEmbeddedByValue()                        {}
 
@@ -218,6 +542,298 @@ func _ClusterService_CreateCluster_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_CloneCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).CloneCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_CloneCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).CloneCluster(ctx, req.(*CloneClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_WatchCluster_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchClusterRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterServiceServer).WatchCluster(m, &grpc.GenericServerStream[WatchClusterRequest, ClusterStatus]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClusterService_WatchClusterServer = grpc.ServerStreamingServer[ClusterStatus]
+
+func _ClusterService_DeleteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).DeleteCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_DeleteCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).DeleteCluster(ctx, req.(*DeleteClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_UpdateCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).UpdateCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_UpdateCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).UpdateCluster(ctx, req.(*UpdateClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ChangeClusterTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeClusterTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ChangeClusterTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_ChangeClusterTier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ChangeClusterTier(ctx, req.(*ChangeClusterTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_GetClusterOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).GetClusterOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_GetClusterOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).GetClusterOperation(ctx, req.(*GetClusterOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_PauseCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).PauseCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_PauseCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).PauseCluster(ctx, req.(*PauseClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ResumeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ResumeCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_ResumeCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ResumeCluster(ctx, req.(*ResumeClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ScaleCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ScaleCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_ScaleCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ScaleCluster(ctx, req.(*ScaleClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterServiceServer).WatchEvents(m, &grpc.GenericServerStream[WatchEventsRequest, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClusterService_WatchEventsServer = grpc.ServerStreamingServer[Event]
+
+func _ClusterService_ExportResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ExportResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_ExportResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ExportResources(ctx, req.(*ExportResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ApplyResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ApplyResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_ApplyResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ApplyResource(ctx, req.(*ApplyResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_AttachBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).AttachBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_AttachBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).AttachBucket(ctx, req.(*AttachBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_DetachBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).DetachBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_DetachBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).DetachBucket(ctx, req.(*DetachBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ListClusterBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClusterBucketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ListClusterBuckets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_ListClusterBuckets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ListClusterBuckets(ctx, req.(*ListClusterBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ListRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ListRegions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_ListRegions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ListRegions(ctx, req.(*ListRegionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ClusterService_ServiceDesc is the grpc.ServiceDesc for ClusterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -226,30 +842,111 @@ var ClusterService_ServiceDesc = grpc.ServiceDesc{
 	HandlerType: (*ClusterServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "ListClusters",
-			Handler:    _ClusterService_ListClusters_Handler,
+			MethodName: "ListClusters",
+			Handler:    _ClusterService_ListClusters_Handler,
+		},
+		{
+			MethodName: "VerifyClusterExists",
+			Handler:    _ClusterService_VerifyClusterExists_Handler,
+		},
+		{
+			MethodName: "GetClusterDetails",
+			Handler:    _ClusterService_GetClusterDetails_Handler,
+		},
+		{
+			MethodName: "CreateCluster",
+			Handler:    _ClusterService_CreateCluster_Handler,
+		},
+		{
+			MethodName: "CloneCluster",
+			Handler:    _ClusterService_CloneCluster_Handler,
+		},
+		{
+			MethodName: "DeleteCluster",
+			Handler:    _ClusterService_DeleteCluster_Handler,
+		},
+		{
+			MethodName: "UpdateCluster",
+			Handler:    _ClusterService_UpdateCluster_Handler,
+		},
+		{
+			MethodName: "ChangeClusterTier",
+			Handler:    _ClusterService_ChangeClusterTier_Handler,
+		},
+		{
+			MethodName: "GetClusterOperation",
+			Handler:    _ClusterService_GetClusterOperation_Handler,
+		},
+		{
+			MethodName: "PauseCluster",
+			Handler:    _ClusterService_PauseCluster_Handler,
 		},
 		{
-			MethodName: "VerifyClusterExists",
-			Handler:    _ClusterService_VerifyClusterExists_Handler,
+			MethodName: "ResumeCluster",
+			Handler:    _ClusterService_ResumeCluster_Handler,
 		},
 		{
-			MethodName: "GetClusterDetails",
-			Handler:    _ClusterService_GetClusterDetails_Handler,
+			MethodName: "ScaleCluster",
+			Handler:    _ClusterService_ScaleCluster_Handler,
 		},
 		{
-			MethodName: "CreateCluster",
-			Handler:    _ClusterService_CreateCluster_Handler,
+			MethodName: "ListEvents",
+			Handler:    _ClusterService_ListEvents_Handler,
+		},
+		{
+			MethodName: "ExportResources",
+			Handler:    _ClusterService_ExportResources_Handler,
+		},
+		{
+			MethodName: "ApplyResource",
+			Handler:    _ClusterService_ApplyResource_Handler,
+		},
+		{
+			MethodName: "AttachBucket",
+			Handler:    _ClusterService_AttachBucket_Handler,
+		},
+		{
+			MethodName: "DetachBucket",
+			Handler:    _ClusterService_DetachBucket_Handler,
+		},
+		{
+			MethodName: "ListClusterBuckets",
+			Handler:    _ClusterService_ListClusterBuckets_Handler,
+		},
+		{
+			MethodName: "ListRegions",
+			Handler:    _ClusterService_ListRegions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCluster",
+			Handler:       _ClusterService_WatchCluster_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _ClusterService_WatchEvents_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/cluster.proto",
 }
 
 const (
-	BucketService_ListBuckets_FullMethodName            = "/cluster.BucketService/ListBuckets"
-	BucketService_VerifyBucketAccess_FullMethodName     = "/cluster.BucketService/VerifyBucketAccess"
-	BucketService_CheckResourceReadiness_FullMethodName = "/cluster.BucketService/CheckResourceReadiness"
+	BucketService_ListBuckets_FullMethodName             = "/cluster.BucketService/ListBuckets"
+	BucketService_VerifyBucketAccess_FullMethodName      = "/cluster.BucketService/VerifyBucketAccess"
+	BucketService_CheckResourceReadiness_FullMethodName  = "/cluster.BucketService/CheckResourceReadiness"
+	BucketService_GetServiceRole_FullMethodName          = "/cluster.BucketService/GetServiceRole"
+	BucketService_CreateBucket_FullMethodName            = "/cluster.BucketService/CreateBucket"
+	BucketService_GetBucket_FullMethodName               = "/cluster.BucketService/GetBucket"
+	BucketService_DeleteBucket_FullMethodName            = "/cluster.BucketService/DeleteBucket"
+	BucketService_UpdateBucket_FullMethodName            = "/cluster.BucketService/UpdateBucket"
+	BucketService_SignObjectURL_FullMethodName           = "/cluster.BucketService/SignObjectURL"
+	BucketService_ListObjects_FullMethodName             = "/cluster.BucketService/ListObjects"
+	BucketService_StartMultipartUpload_FullMethodName    = "/cluster.BucketService/StartMultipartUpload"
+	BucketService_CompleteMultipartUpload_FullMethodName = "/cluster.BucketService/CompleteMultipartUpload"
+	BucketService_AbortMultipartUpload_FullMethodName    = "/cluster.BucketService/AbortMultipartUpload"
 )
 
 // BucketServiceClient is the client API for BucketService service.
@@ -264,6 +961,26 @@ type BucketServiceClient interface {
 	VerifyBucketAccess(ctx context.Context, in *VerifyBucketAccessRequest, opts ...grpc.CallOption) (*VerifyBucketAccessResponse, error)
 	// CheckResourceReadiness checks if all required resources are ready
 	CheckResourceReadiness(ctx context.Context, in *CheckResourceReadinessRequest, opts ...grpc.CallOption) (*CheckResourceReadinessResponse, error)
+	// GetServiceRole returns the NStream identity that is granted access to customer buckets
+	GetServiceRole(ctx context.Context, in *GetServiceRoleRequest, opts ...grpc.CallOption) (*GetServiceRoleResponse, error)
+	// CreateBucket creates a new bucket in the user's cloud account
+	CreateBucket(ctx context.Context, in *CreateBucketRequest, opts ...grpc.CallOption) (*CreateBucketResponse, error)
+	// GetBucket retrieves a single bucket
+	GetBucket(ctx context.Context, in *GetBucketRequest, opts ...grpc.CallOption) (*GetBucketResponse, error)
+	// DeleteBucket deletes a bucket that is not attached to any cluster
+	DeleteBucket(ctx context.Context, in *DeleteBucketRequest, opts ...grpc.CallOption) (*DeleteBucketResponse, error)
+	// UpdateBucket updates the fields of a bucket selected by the update mask
+	UpdateBucket(ctx context.Context, in *UpdateBucketRequest, opts ...grpc.CallOption) (*UpdateBucketResponse, error)
+	// SignObjectURL issues a short-lived signed URL for reading or writing a single object
+	SignObjectURL(ctx context.Context, in *SignObjectURLRequest, opts ...grpc.CallOption) (*SignObjectURLResponse, error)
+	// ListObjects lists the objects in a bucket under a prefix
+	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
+	// StartMultipartUpload begins an upload whose parts are sent to URLs from SignObjectURL
+	StartMultipartUpload(ctx context.Context, in *StartMultipartUploadRequest, opts ...grpc.CallOption) (*StartMultipartUploadResponse, error)
+	// CompleteMultipartUpload assembles the uploaded parts into the final object
+	CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*CompleteMultipartUploadResponse, error)
+	// AbortMultipartUpload discards an unfinished upload and its parts
+	AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...grpc.CallOption) (*AbortMultipartUploadResponse, error)
 }
 
 type bucketServiceClient struct {
@@ -304,6 +1021,106 @@ func (c *bucketServiceClient) CheckResourceReadiness(ctx context.Context, in *Ch
 	return out, nil
 }
 
+func (c *bucketServiceClient) GetServiceRole(ctx context.Context, in *GetServiceRoleRequest, opts ...grpc.CallOption) (*GetServiceRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServiceRoleResponse)
+	err := c.cc.Invoke(ctx, BucketService_GetServiceRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) CreateBucket(ctx context.Context, in *CreateBucketRequest, opts ...grpc.CallOption) (*CreateBucketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBucketResponse)
+	err := c.cc.Invoke(ctx, BucketService_CreateBucket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) GetBucket(ctx context.Context, in *GetBucketRequest, opts ...grpc.CallOption) (*GetBucketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBucketResponse)
+	err := c.cc.Invoke(ctx, BucketService_GetBucket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) DeleteBucket(ctx context.Context, in *DeleteBucketRequest, opts ...grpc.CallOption) (*DeleteBucketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBucketResponse)
+	err := c.cc.Invoke(ctx, BucketService_DeleteBucket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) UpdateBucket(ctx context.Context, in *UpdateBucketRequest, opts ...grpc.CallOption) (*UpdateBucketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBucketResponse)
+	err := c.cc.Invoke(ctx, BucketService_UpdateBucket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) SignObjectURL(ctx context.Context, in *SignObjectURLRequest, opts ...grpc.CallOption) (*SignObjectURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignObjectURLResponse)
+	err := c.cc.Invoke(ctx, BucketService_SignObjectURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListObjectsResponse)
+	err := c.cc.Invoke(ctx, BucketService_ListObjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) StartMultipartUpload(ctx context.Context, in *StartMultipartUploadRequest, opts ...grpc.CallOption) (*StartMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, BucketService_StartMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*CompleteMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, BucketService_CompleteMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bucketServiceClient) AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...grpc.CallOption) (*AbortMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbortMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, BucketService_AbortMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BucketServiceServer is the server API for BucketService service.
 // All implementations must embed UnimplementedBucketServiceServer
 // for forward compatibility.
@@ -316,6 +1133,26 @@ type BucketServiceServer interface {
 	VerifyBucketAccess(context.Context, *VerifyBucketAccessRequest) (*VerifyBucketAccessResponse, error)
 	// CheckResourceReadiness checks if all required resources are ready
 	CheckResourceReadiness(context.Context, *CheckResourceReadinessRequest) (*CheckResourceReadinessResponse, error)
+	// GetServiceRole returns the NStream identity that is granted access to customer buckets
+	GetServiceRole(context.Context, *GetServiceRoleRequest) (*GetServiceRoleResponse, error)
+	// CreateBucket creates a new bucket in the user's cloud account
+	CreateBucket(context.Context, *CreateBucketRequest) (*CreateBucketResponse, error)
+	// GetBucket retrieves a single bucket
+	GetBucket(context.Context, *GetBucketRequest) (*GetBucketResponse, error)
+	// DeleteBucket deletes a bucket that is not attached to any cluster
+	DeleteBucket(context.Context, *DeleteBucketRequest) (*DeleteBucketResponse, error)
+	// UpdateBucket updates the fields of a bucket selected by the update mask
+	UpdateBucket(context.Context, *UpdateBucketRequest) (*UpdateBucketResponse, error)
+	// SignObjectURL issues a short-lived signed URL for reading or writing a single object
+	SignObjectURL(context.Context, *SignObjectURLRequest) (*SignObjectURLResponse, error)
+	// ListObjects lists the objects in a bucket under a prefix
+	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
+	// StartMultipartUpload begins an upload whose parts are sent to URLs from SignObjectURL
+	StartMultipartUpload(context.Context, *StartMultipartUploadRequest) (*StartMultipartUploadResponse, error)
+	// CompleteMultipartUpload assembles the uploaded parts into the final object
+	CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*CompleteMultipartUploadResponse, error)
+	// AbortMultipartUpload discards an unfinished upload and its parts
+	AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadResponse, error)
 	mustEmbedUnimplementedBucketServiceServer()
 }
 
@@ -335,6 +1172,36 @@ func (UnimplementedBucketServiceServer) VerifyBucketAccess(context.Context, *Ver
 func (UnimplementedBucketServiceServer) CheckResourceReadiness(context.Context, *CheckResourceReadinessRequest) (*CheckResourceReadinessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckResourceReadiness not implemented")
 }
+func (UnimplementedBucketServiceServer) GetServiceRole(context.Context, *GetServiceRoleRequest) (*GetServiceRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceRole not implemented")
+}
+func (UnimplementedBucketServiceServer) CreateBucket(context.Context, *CreateBucketRequest) (*CreateBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBucket not implemented")
+}
+func (UnimplementedBucketServiceServer) GetBucket(context.Context, *GetBucketRequest) (*GetBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBucket not implemented")
+}
+func (UnimplementedBucketServiceServer) DeleteBucket(context.Context, *DeleteBucketRequest) (*DeleteBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBucket not implemented")
+}
+func (UnimplementedBucketServiceServer) UpdateBucket(context.Context, *UpdateBucketRequest) (*UpdateBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBucket not implemented")
+}
+func (UnimplementedBucketServiceServer) SignObjectURL(context.Context, *SignObjectURLRequest) (*SignObjectURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignObjectURL not implemented")
+}
+func (UnimplementedBucketServiceServer) ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
+}
+func (UnimplementedBucketServiceServer) StartMultipartUpload(context.Context, *StartMultipartUploadRequest) (*StartMultipartUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMultipartUpload not implemented")
+}
+func (UnimplementedBucketServiceServer) CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*CompleteMultipartUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteMultipartUpload not implemented")
+}
+func (UnimplementedBucketServiceServer) AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortMultipartUpload not implemented")
+}
 func (UnimplementedBucketServiceServer) mustEmbedUnimplementedBucketServiceServer() {}
 func (UnimplementedBucketServiceServer) testEmbeddedByValue()                       {}
 
@@ -410,6 +1277,186 @@ func _BucketService_CheckResourceReadiness_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BucketService_GetServiceRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).GetServiceRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_GetServiceRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).GetServiceRole(ctx, req.(*GetServiceRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).CreateBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_CreateBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).CreateBucket(ctx, req.(*CreateBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_GetBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).GetBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_GetBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).GetBucket(ctx, req.(*GetBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_DeleteBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).DeleteBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_DeleteBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).DeleteBucket(ctx, req.(*DeleteBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_UpdateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).UpdateBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_UpdateBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).UpdateBucket(ctx, req.(*UpdateBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_SignObjectURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignObjectURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).SignObjectURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_SignObjectURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).SignObjectURL(ctx, req.(*SignObjectURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).ListObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_ListObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).ListObjects(ctx, req.(*ListObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_StartMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).StartMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_StartMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).StartMultipartUpload(ctx, req.(*StartMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_CompleteMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).CompleteMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_CompleteMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).CompleteMultipartUpload(ctx, req.(*CompleteMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BucketService_AbortMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BucketServiceServer).AbortMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BucketService_AbortMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BucketServiceServer).AbortMultipartUpload(ctx, req.(*AbortMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BucketService_ServiceDesc is the grpc.ServiceDesc for BucketService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -429,6 +1476,46 @@ var BucketService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckResourceReadiness",
 			Handler:    _BucketService_CheckResourceReadiness_Handler,
 		},
+		{
+			MethodName: "GetServiceRole",
+			Handler:    _BucketService_GetServiceRole_Handler,
+		},
+		{
+			MethodName: "CreateBucket",
+			Handler:    _BucketService_CreateBucket_Handler,
+		},
+		{
+			MethodName: "GetBucket",
+			Handler:    _BucketService_GetBucket_Handler,
+		},
+		{
+			MethodName: "DeleteBucket",
+			Handler:    _BucketService_DeleteBucket_Handler,
+		},
+		{
+			MethodName: "UpdateBucket",
+			Handler:    _BucketService_UpdateBucket_Handler,
+		},
+		{
+			MethodName: "SignObjectURL",
+			Handler:    _BucketService_SignObjectURL_Handler,
+		},
+		{
+			MethodName: "ListObjects",
+			Handler:    _BucketService_ListObjects_Handler,
+		},
+		{
+			MethodName: "StartMultipartUpload",
+			Handler:    _BucketService_StartMultipartUpload_Handler,
+		},
+		{
+			MethodName: "CompleteMultipartUpload",
+			Handler:    _BucketService_CompleteMultipartUpload_Handler,
+		},
+		{
+			MethodName: "AbortMultipartUpload",
+			Handler:    _BucketService_AbortMultipartUpload_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/cluster.proto",
@@ -4,7 +4,7 @@
 export PATH="$PATH:$(go env GOPATH)/bin"
 
 # Create proto output directory
-mkdir -p proto/auth proto/cluster
+mkdir -p proto/auth proto/cluster proto/billing proto/logs proto/metrics
 
 # Generate Go code from proto files
 protoc --go_out=. --go_opt=module=github.com/nstream-ai/nstream-ai-mothership \
@@ -15,6 +15,18 @@ protoc --go_out=. --go_opt=module=github.com/nstream-ai/nstream-ai-mothership \
     --go-grpc_out=. --go-grpc_opt=module=github.com/nstream-ai/nstream-ai-mothership \
     proto/cluster.proto
 
+protoc --go_out=. --go_opt=module=github.com/nstream-ai/nstream-ai-mothership \
+    --go-grpc_out=. --go-grpc_opt=module=github.com/nstream-ai/nstream-ai-mothership \
+    proto/billing.proto
+
+protoc --go_out=. --go_opt=module=github.com/nstream-ai/nstream-ai-mothership \
+    --go-grpc_out=. --go-grpc_opt=module=github.com/nstream-ai/nstream-ai-mothership \
+    proto/logs.proto
+
+protoc --go_out=. --go_opt=module=github.com/nstream-ai/nstream-ai-mothership \
+    --go-grpc_out=. --go-grpc_opt=module=github.com/nstream-ai/nstream-ai-mothership \
+    proto/metrics.proto
+
 # Move generated files to the correct location
 # mv proto/gen/github.com/nstream-ai/nstream-ai-mothership/proto/* proto/
 # rm -rf proto/gen 
\ No newline at end of file